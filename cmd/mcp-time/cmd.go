@@ -13,6 +13,7 @@ import (
 	"github.com/prometheus/common/version"
 	"github.com/spf13/cobra"
 
+	"github.com/TheoBrigitte/mcp-time/pkg/holidays"
 	"github.com/TheoBrigitte/mcp-time/pkg/mcp"
 )
 
@@ -22,6 +23,8 @@ var (
 
 	// address is the listen address for the HTTP server.
 	address string
+	// holidayFiles is the list of custom holiday files to load.
+	holidayFiles []string
 	// logFile is the path to the log file. If empty, logs are disabled for stdio transport.
 	logFile string
 	// transport is the transport layer to use for MCP communication.
@@ -40,6 +43,7 @@ var cmd = &cobra.Command{
 // init initializes command line flags for the application.
 func init() {
 	cmd.Flags().StringVar(&address, "address", "http://localhost:8080/mcp", "Listen address for Stream HTTP Server (only for --transport stream)")
+	cmd.Flags().StringSliceVar(&holidayFiles, "holidays-file", nil, "Path to a custom holiday file in JSON format (can be repeated)")
 	cmd.Flags().StringVar(&logFile, "log-file", "", "Path to log file (logs is disabled if not specified)")
 	cmd.Flags().StringVarP(&transport, "transport", "t", mcp.TransportNames[mcp.TransportSTDIO], fmt.Sprintf("Transport layer: %v.", strings.Join(mcp.GetTransports(), ", ")))
 	cmd.Flags().BoolVar(&versionFlag, "version", false, "Print version information and exit")
@@ -90,6 +94,15 @@ func runner(c *cobra.Command, args []string) (err error) {
 		cancel()
 	}()
 
+	// Load custom holiday files before registering the tools.
+	for _, holidayFile := range holidayFiles {
+		err := holidays.LoadFile(holidayFile)
+		if err != nil {
+			return err
+		}
+		slog.Info("holiday file loaded", "path", holidayFile)
+	}
+
 	// Create a new MCP server instance.
 	server := mcp.NewServer(name, version.Version)
 
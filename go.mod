@@ -6,6 +6,7 @@ require (
 	github.com/araddon/dateparse v0.0.0-20210429162001-6b43995a97de
 	github.com/mark3labs/mcp-go v0.33.0
 	github.com/prometheus/common v0.65.0
+	github.com/ringsaturn/tzf-rel-lite v0.0.2025-b2
 	github.com/spf13/cobra v1.9.1
 	github.com/tj/go-naturaldate v1.3.0
 )
@@ -0,0 +1,220 @@
+package datetime
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/TheoBrigitte/mcp-time/pkg/holidays"
+)
+
+// maxBusinessDays is the maximum number of business days added by AddBusinessDays, about 380 years.
+const maxBusinessDays = 100000
+
+// defaultWeekend is the list of weekend days used when none is specified.
+var defaultWeekend = []time.Weekday{time.Saturday, time.Sunday}
+
+// GetDefaultWeekend returns the names of the default weekend days.
+func GetDefaultWeekend() []string {
+	names := make([]string, 0, len(defaultWeekend))
+	for _, day := range defaultWeekend {
+		names = append(names, day.String())
+	}
+
+	return names
+}
+
+// businessCalendar defines which days are business days.
+type businessCalendar struct {
+	weekend [7]bool
+	// holidays is the optional holiday calendar. Holidays and their observed days are not business days.
+	holidays *holidays.Calendar
+}
+
+// newBusinessCalendar creates a businessCalendar from a list of weekend day names and a holiday country code.
+// If weekend is nil, the default weekend is used. If country is empty, no holidays are used.
+func newBusinessCalendar(weekend []string, country string) (c businessCalendar, err error) {
+	if weekend == nil {
+		weekend = GetDefaultWeekend()
+	}
+
+	if country != "" {
+		c.holidays, err = lookupHolidays(country)
+		if err != nil {
+			return c, err
+		}
+	}
+
+	for _, name := range weekend {
+		day, err := parseWeekday(name)
+		if err != nil {
+			return c, err
+		}
+		c.weekend[day] = true
+	}
+
+	if c.weekend == [7]bool{true, true, true, true, true, true, true} {
+		return c, fmt.Errorf("invalid_weekend: At least one day of the week must be a business day")
+	}
+
+	return c, nil
+}
+
+// isBusinessDay reports whether the day of t, in its location, is a business day.
+func (c businessCalendar) isBusinessDay(t time.Time) bool {
+	if c.weekend[t.Weekday()] {
+		return false
+	}
+
+	return c.holidays == nil || len(c.holidays.On(t)) == 0
+}
+
+// addBusinessDays moves t by the given number of business days, keeping its wall clock.
+// When days is zero, t is moved forward to the next business day if it is not one.
+func (c businessCalendar) addBusinessDays(t time.Time, days int) time.Time {
+	step := 1
+	if days < 0 {
+		step, days = -1, -days
+	}
+
+	for !c.isBusinessDay(t) && days == 0 {
+		t = t.AddDate(0, 0, 1)
+	}
+
+	for days > 0 {
+		t = t.AddDate(0, 0, step)
+		if c.isBusinessDay(t) {
+			days--
+		}
+	}
+
+	return t
+}
+
+// countBusinessDays counts the business days from the day of start (included) to the day of end (excluded).
+// The result is negative if end is before start.
+func (c businessCalendar) countBusinessDays(start, end time.Time) int {
+	// Compare calendar dates only, in the location of start.
+	year, month, day := start.Date()
+	current := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	year, month, day = end.In(start.Location()).Date()
+	last := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+
+	sign := 1
+	if last.Before(current) {
+		sign = -1
+		current, last = last, current
+	}
+
+	// Count whole weeks at once, then the remaining days one by one.
+	businessDaysPerWeek := 0
+	for _, weekend := range c.weekend {
+		if !weekend {
+			businessDaysPerWeek++
+		}
+	}
+	weeks := int(last.Sub(current) / (7 * 24 * time.Hour))
+	count := weeks * businessDaysPerWeek
+	first := current
+	current = current.AddDate(0, 0, 7*weeks)
+
+	for current.Before(last) {
+		if !c.weekend[current.Weekday()] {
+			count++
+		}
+		current = current.AddDate(0, 0, 1)
+	}
+
+	// Remove the holidays which fall on weekdays.
+	if c.holidays != nil {
+		seen := map[holidays.Date]bool{}
+		for year := first.Year(); year <= last.Year(); year++ {
+			for _, h := range c.holidays.Holidays(year) {
+				date := h.Date.Time()
+				if seen[h.Date] || date.Before(first) || !date.Before(last) || c.weekend[date.Weekday()] {
+					continue
+				}
+				seen[h.Date] = true
+				count--
+			}
+		}
+	}
+
+	return sign * count
+}
+
+// parseWeekday parses a weekday name, either in full or abbreviated to its first three letters (e.g., "Monday", "mon").
+func parseWeekday(name string) (time.Weekday, error) {
+	day, err := holidays.ParseWeekday(name)
+	if err != nil {
+		return 0, fmt.Errorf("invalid_weekday: Invalid weekday name: %s", name)
+	}
+
+	return day, nil
+}
+
+// AddBusinessDays adds a number of business days to a given time string, skipping weekend days and,
+// when country is set, the public holidays of that country.
+// The input time is interpreted in the given timezone when it does not carry its own, and the result
+// is returned in that timezone and the specified format. A negative number of days moves backward.
+// If weekend is nil, Saturday and Sunday are used. At most maxBusinessDays days can be added or subtracted.
+func AddBusinessDays(inputTime string, days int, weekend []string, country, timezone string, inputFormat InputFormat, format Format) (output string, err error) {
+	if days < -maxBusinessDays || days > maxBusinessDays {
+		return "", fmt.Errorf("invalid_days: Days must be between -%d and %d", maxBusinessDays, maxBusinessDays)
+	}
+
+	calendar, err := newBusinessCalendar(weekend, country)
+	if err != nil {
+		return "", err
+	}
+
+	dt, err := fromStringWithTimezone(inputTime, inputFormat, timezone, "time")
+	if err != nil {
+		return "", err
+	}
+
+	// Days are counted in the requested timezone.
+	if timezone != "" {
+		location, err := ResolveTimezone(timezone)
+		if err != nil {
+			return "", err
+		}
+		dt.time = dt.time.In(location)
+	}
+
+	dt.time = calendar.addBusinessDays(dt.time, days)
+
+	return dt.format(format, timezone)
+}
+
+// CountBusinessDays counts the business days between two time strings, from the day of startTime (included)
+// to the day of endTime (excluded), skipping weekend days and, when country is set, the public holidays of that country.
+// The result is negative if endTime is before startTime.
+// Both times are interpreted, and their days determined, in the given timezone.
+// If weekend is nil, Saturday and Sunday are used.
+func CountBusinessDays(startTime, endTime string, weekend []string, country, timezone string, inputFormat InputFormat) (int, error) {
+	calendar, err := newBusinessCalendar(weekend, country)
+	if err != nil {
+		return 0, err
+	}
+
+	start, err := fromStringWithTimezone(startTime, inputFormat, timezone, "time")
+	if err != nil {
+		return 0, err
+	}
+
+	end, err := fromStringWithTimezone(endTime, inputFormat, timezone, "end_time")
+	if err != nil {
+		return 0, err
+	}
+
+	// Days are counted in the requested timezone.
+	if timezone != "" {
+		location, err := ResolveTimezone(timezone)
+		if err != nil {
+			return 0, err
+		}
+		start.time = start.time.In(location)
+	}
+
+	return calendar.countBusinessDays(start.time, end.time), nil
+}
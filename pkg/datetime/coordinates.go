@@ -0,0 +1,76 @@
+package datetime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/TheoBrigitte/mcp-time/pkg/geotz"
+)
+
+// CoordinatesTimezone describes the timezone of geographic coordinates at a given time.
+type CoordinatesTimezone struct {
+	// Latitude and Longitude are the coordinates, in decimal degrees.
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	// OtherTimezones lists the other timezones claiming the coordinates, in disputed areas.
+	OtherTimezones []string `json:"other_timezones,omitempty"`
+
+	*TimezoneInfo
+}
+
+// TimezoneForCoordinates returns the timezone of geographic coordinates, in decimal degrees, using the embedded
+// timezone boundaries, along with its state at a given time (see GetTimezoneInfo).
+func TimezoneForCoordinates(latitude, longitude float64, inputTime string, inputFormat InputFormat, format Format) (*CoordinatesTimezone, error) {
+	timezones, err := coordinatesTimezones(latitude, longitude)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := GetTimezoneInfo(timezones[0], inputTime, inputFormat, format)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CoordinatesTimezone{
+		Latitude:       latitude,
+		Longitude:      longitude,
+		OtherTimezones: timezones[1:],
+		TimezoneInfo:   info,
+	}, nil
+}
+
+// coordinatesTimezones returns the timezones containing geographic coordinates, the first one being preferred.
+func coordinatesTimezones(latitude, longitude float64) ([]string, error) {
+	if !geotz.ValidCoordinates(latitude, longitude) {
+		return nil, fmt.Errorf("invalid_coordinates: Latitude must be between -90 and 90 and longitude between -180 and 180, got %g, %g", latitude, longitude)
+	}
+
+	timezones, err := geotz.Lookup(latitude, longitude)
+	if err != nil {
+		return nil, fmt.Errorf("invalid_coordinates: %w", err)
+	}
+
+	return timezones, nil
+}
+
+// parseCoordinates parses geographic coordinates written as a latitude and a longitude in decimal degrees,
+// separated by a comma (e.g., "48.8566,2.3522", "40.7128, -74.0060").
+func parseCoordinates(s string) (float64, float64, bool) {
+	lat, lng, found := strings.Cut(s, ",")
+	if !found {
+		return 0, 0, false
+	}
+
+	latitude, err := strconv.ParseFloat(strings.TrimSpace(lat), 64)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	longitude, err := strconv.ParseFloat(strings.TrimSpace(lng), 64)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	return latitude, longitude, true
+}
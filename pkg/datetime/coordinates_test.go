@@ -0,0 +1,57 @@
+package datetime
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestTimezoneForCoordinates tests the TimezoneForCoordinates function.
+func TestTimezoneForCoordinates(t *testing.T) {
+	tests := []struct {
+		name             string
+		latitude         float64
+		longitude        float64
+		expectedTimezone string
+		expectedOffset   string
+	}{
+		{"Paris", 48.8566, 2.3522, "Europe/Paris", "+02:00"},
+		{"New York", 40.7128, -74.0060, "America/New_York", "-04:00"},
+		{"Kathmandu", 27.7172, 85.3240, "Asia/Kathmandu", "+05:45"},
+		{"Pacific Ocean", 0, -150, "Etc/GMT+10", "-10:00"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := TimezoneForCoordinates(test.latitude, test.longitude, "2025-07-08 12:00", InputFormat{}, Format{Layout: "RFC3339"})
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+
+			if result.Timezone != test.expectedTimezone || result.Offset != test.expectedOffset {
+				t.Errorf("expected %s at %s, got %s at %s", test.expectedTimezone, test.expectedOffset, result.Timezone, result.Offset)
+			}
+		})
+	}
+
+	_, err := TimezoneForCoordinates(95, 0, "", InputFormat{}, Format{})
+	if err == nil || !strings.HasPrefix(err.Error(), "invalid_coordinates:") {
+		t.Errorf("expected invalid_coordinates error, got %v", err)
+	}
+}
+
+// TestResolveTimezoneCoordinates tests that timezone parameters accept coordinates.
+func TestResolveTimezoneCoordinates(t *testing.T) {
+	output, err := ConvertTime("2025-07-08 15:00", "40.7128,-74.0060", "48.8566, 2.3522", InputFormat{}, Format{Layout: "RFC3339"})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if expected := "2025-07-08T21:00:00+02:00"; output != expected {
+		t.Errorf("expected %s, got %s", expected, output)
+	}
+
+	_, err = ResolveTimezone("48.8566,200")
+	if err == nil || !strings.HasPrefix(err.Error(), "invalid_coordinates:") {
+		t.Errorf("expected invalid_coordinates error, got %v", err)
+	}
+}
@@ -0,0 +1,327 @@
+package datetime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxCronCount is the maximum number of occurrences returned by CronNext.
+const maxCronCount = 100
+
+// cronSearchYears is the number of years searched for occurrences before giving up.
+const cronSearchYears = 5
+
+// cronMacros maps cron macros to their equivalent expression.
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// cronField describes the allowed values of a cron field.
+type cronField struct {
+	name     string
+	min, max int
+	// names maps value names (e.g., "jan", "mon") to their value.
+	names map[string]int
+}
+
+var (
+	cronSecond = cronField{name: "second", min: 0, max: 59}
+	cronMinute = cronField{name: "minute", min: 0, max: 59}
+	cronHour   = cronField{name: "hour", min: 0, max: 23}
+	cronDom    = cronField{name: "day of month", min: 1, max: 31}
+	cronMonth  = cronField{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// Both 0 and 7 are Sunday.
+	cronDow = cronField{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+// cronSchedule is a parsed cron expression. Each field is a bit set of the matching values.
+type cronSchedule struct {
+	second, minute, hour, dom, month, dow uint64
+	// domStar and dowStar are set when the day fields are unrestricted ("*" or "?").
+	// When both day fields are restricted, a day matches if either of them matches.
+	domStar, dowStar bool
+}
+
+// parseCron parses a cron expression.
+//
+// It accepts the standard 5 fields (minute, hour, day of month, month, day of week), an optional leading
+// seconds field (6 fields), and the macros @yearly, @annually, @monthly, @weekly, @daily, @midnight and @hourly.
+// Each field accepts "*", values, ranges ("1-5"), steps ("*/15", "0-30/5", "10/5") and lists ("1,15,30").
+// Months and days of week also accept names ("JAN", "MON"), and the day fields accept "?" as "*".
+func parseCron(expression string) (*cronSchedule, error) {
+	expression = strings.TrimSpace(expression)
+	if macro, ok := cronMacros[strings.ToLower(expression)]; ok {
+		expression = macro
+	}
+
+	fields := strings.Fields(expression)
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		return nil, fmt.Errorf("expected 5 or 6 fields, got %d", len(fields))
+	}
+
+	s := &cronSchedule{
+		domStar: fields[3] == "*" || fields[3] == "?",
+		dowStar: fields[5] == "*" || fields[5] == "?",
+	}
+
+	var err error
+	for i, f := range []struct {
+		field cronField
+		bits  *uint64
+	}{
+		{cronSecond, &s.second},
+		{cronMinute, &s.minute},
+		{cronHour, &s.hour},
+		{cronDom, &s.dom},
+		{cronMonth, &s.month},
+		{cronDow, &s.dow},
+	} {
+		*f.bits, err = f.field.parse(fields[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Sunday can be written as 7.
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+
+	return s, nil
+}
+
+// parse parses the value of a cron field into a bit set.
+func (f cronField) parse(value string) (bits uint64, err error) {
+	for _, item := range strings.Split(value, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(item, "/")
+
+		step := 1
+		if hasStep {
+			step, err = strconv.Atoi(stepPart)
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step %q in %s field", stepPart, f.name)
+			}
+		}
+
+		var low, high int
+		switch {
+		case rangePart == "*" || (rangePart == "?" && (f.name == cronDom.name || f.name == cronDow.name)):
+			low, high = f.min, f.max
+		case strings.Contains(rangePart, "-"):
+			lowPart, highPart, _ := strings.Cut(rangePart, "-")
+			low, err = f.value(lowPart)
+			if err != nil {
+				return 0, err
+			}
+			high, err = f.value(highPart)
+			if err != nil {
+				return 0, err
+			}
+			if low > high {
+				return 0, fmt.Errorf("invalid range %q in %s field", rangePart, f.name)
+			}
+		default:
+			low, err = f.value(rangePart)
+			if err != nil {
+				return 0, err
+			}
+			high = low
+			// A single value with a step runs to the end of the range (e.g., "10/5").
+			if hasStep {
+				high = f.max
+			}
+		}
+
+		for v := low; v <= high; v += step {
+			bits |= 1 << v
+		}
+	}
+
+	return bits, nil
+}
+
+// value parses a single value of a cron field, either a number or a name.
+func (f cronField) value(s string) (int, error) {
+	if v, ok := f.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+
+	v, err := strconv.Atoi(s)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("invalid value %q in %s field: expected %d-%d", s, f.name, f.min, f.max)
+	}
+
+	return v, nil
+}
+
+// matchDay reports whether the day of the wall clock t matches the day fields.
+func (s *cronSchedule) matchDay(t time.Time) bool {
+	dom := s.dom&(1<<t.Day()) != 0
+	dow := s.dow&(1<<t.Weekday()) != 0
+
+	if s.domStar || s.dowStar {
+		return dom && dow
+	}
+
+	return dom || dow
+}
+
+// next returns the first matching wall clock strictly after the wall clock t, as returned by wallClock.
+// It returns false if there is no match within cronSearchYears.
+func (s *cronSchedule) next(t time.Time) (time.Time, bool) {
+	limit := t.AddDate(cronSearchYears, 0, 0)
+	t = t.Truncate(time.Second).Add(time.Second)
+
+	for t.Before(limit) {
+		year, month, day := t.Date()
+		switch {
+		case s.month&(1<<month) == 0:
+			t = time.Date(year, month+1, 1, 0, 0, 0, 0, time.UTC)
+		case !s.matchDay(t):
+			t = time.Date(year, month, day+1, 0, 0, 0, 0, time.UTC)
+		case s.hour&(1<<t.Hour()) == 0:
+			t = t.Truncate(time.Hour).Add(time.Hour)
+		case s.minute&(1<<t.Minute()) == 0:
+			t = t.Truncate(time.Minute).Add(time.Minute)
+		case s.second&(1<<t.Second()) == 0:
+			t = t.Add(time.Second)
+		default:
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// previous returns the last matching wall clock strictly before the wall clock t, as returned by wallClock.
+// It returns false if there is no match within cronSearchYears.
+func (s *cronSchedule) previous(t time.Time) (time.Time, bool) {
+	limit := t.AddDate(-cronSearchYears, 0, 0)
+	if truncated := t.Truncate(time.Second); truncated.Before(t) {
+		t = truncated
+	} else {
+		t = t.Add(-time.Second)
+	}
+
+	for t.After(limit) {
+		year, month, day := t.Date()
+		switch {
+		case s.month&(1<<month) == 0:
+			t = time.Date(year, month, 1, 0, 0, 0, 0, time.UTC).Add(-time.Second)
+		case !s.matchDay(t):
+			t = time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Add(-time.Second)
+		case s.hour&(1<<t.Hour()) == 0:
+			t = t.Truncate(time.Hour).Add(-time.Second)
+		case s.minute&(1<<t.Minute()) == 0:
+			t = t.Truncate(time.Minute).Add(-time.Second)
+		case s.second&(1<<t.Second()) == 0:
+			t = t.Add(-time.Second)
+		default:
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// occurrences returns up to count instants matching the schedule in location, after (or before, if previous is set)
+// the reference instant. Matching is done on the wall clock of location: a wall clock falling in a daylight saving time
+// gap runs once at the end of the gap, and a repeated wall clock runs once, at its first occurrence.
+func (s *cronSchedule) occurrences(reference time.Time, location *time.Location, count int, previous bool) []time.Time {
+	var result []time.Time
+
+	last := reference
+	wall := wallClock(reference.In(location))
+	for len(result) < count {
+		var ok bool
+		if previous {
+			wall, ok = s.previous(wall)
+		} else {
+			wall, ok = s.next(wall)
+		}
+		if !ok {
+			break
+		}
+
+		// Skip instants which do not move away from the reference, which happens when
+		// several wall clocks in a gap resolve to the same instant, or around repeated wall clocks.
+		instant := fromWallClock(wall, location)
+		if (!previous && !instant.After(last)) || (previous && !instant.Before(last)) {
+			continue
+		}
+
+		result = append(result, instant)
+		last = instant
+	}
+
+	return result
+}
+
+// CronNext returns the next (or previous) count occurrences of a cron expression after (or before) a reference time.
+// The expression is evaluated on the wall clock of the given timezone, which is also used for input times without
+// timezone and for the output. Direction is either "next" (default) or "previous".
+func CronNext(expression, inputTime, timezone, direction string, count int, inputFormat InputFormat, format Format) ([]string, error) {
+	schedule, err := parseCron(expression)
+	if err != nil {
+		return nil, fmt.Errorf("invalid_cron: Invalid cron expression %q: %s", expression, err)
+	}
+
+	if count < 1 || count > maxCronCount {
+		return nil, fmt.Errorf("invalid_count: Count must be between 1 and %d", maxCronCount)
+	}
+
+	var previous bool
+	switch direction {
+	case "", "next":
+	case "previous":
+		previous = true
+	default:
+		return nil, fmt.Errorf("invalid_direction: Direction must be 'next' or 'previous': %s", direction)
+	}
+
+	reference, err := fromStringWithTimezone(inputTime, inputFormat, timezone, "time")
+	if err != nil {
+		return nil, err
+	}
+
+	var location = defaultLocation
+	if timezone != "" {
+		location, err = ResolveTimezone(timezone)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	instants := schedule.occurrences(reference.time, location, count, previous)
+	if len(instants) == 0 {
+		return nil, fmt.Errorf("no_occurrence: No occurrence of %q within %d years", expression, cronSearchYears)
+	}
+
+	output := make([]string, 0, len(instants))
+	for _, instant := range instants {
+		o, err := fromTime(instant).format(format, timezone)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, o)
+	}
+
+	return output, nil
+}
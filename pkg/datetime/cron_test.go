@@ -0,0 +1,205 @@
+package datetime
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestParseCronInvalid tests that parseCron rejects invalid expressions.
+func TestParseCronInvalid(t *testing.T) {
+	tests := []string{
+		"",
+		"* * * *",
+		"* * * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"* * * FOO *",
+		"? * * * *",
+		"@reboot",
+	}
+
+	for _, test := range tests {
+		t.Run(test, func(t *testing.T) {
+			_, err := parseCron(test)
+			if err == nil {
+				t.Errorf("expected error for %q", test)
+			}
+		})
+	}
+}
+
+// TestCronNext tests the CronNext function.
+func TestCronNext(t *testing.T) {
+	tests := []struct {
+		name           string
+		expression     string
+		inputTime      string
+		timezone       string
+		direction      string
+		count          int
+		expectedOutput []string
+	}{
+		{
+			"every 15 minutes",
+			"*/15 * * * *",
+			"2025-07-08T12:34:56Z",
+			"",
+			"",
+			3,
+			[]string{"2025-07-08T12:45:00Z", "2025-07-08T13:00:00Z", "2025-07-08T13:15:00Z"},
+		},
+		{
+			"strictly after reference",
+			"0 12 * * *",
+			"2025-07-08T12:00:00Z",
+			"",
+			"next",
+			1,
+			[]string{"2025-07-09T12:00:00Z"},
+		},
+		{
+			"previous",
+			"0 12 * * *",
+			"2025-07-08T12:00:00Z",
+			"",
+			"previous",
+			2,
+			[]string{"2025-07-07T12:00:00Z", "2025-07-06T12:00:00Z"},
+		},
+		{
+			"seconds field",
+			"30 0 9 * * *",
+			"2025-07-08T12:00:00Z",
+			"",
+			"",
+			1,
+			[]string{"2025-07-09T09:00:30Z"},
+		},
+		{
+			"macro",
+			"@monthly",
+			"2025-07-08T12:00:00Z",
+			"",
+			"",
+			2,
+			[]string{"2025-08-01T00:00:00Z", "2025-09-01T00:00:00Z"},
+		},
+		{
+			"names and ranges",
+			"0 9 * JAN-MAR MON-FRI",
+			"2025-03-28T12:00:00Z",
+			"",
+			"",
+			2,
+			[]string{"2025-03-31T09:00:00Z", "2026-01-01T09:00:00Z"},
+		},
+		{
+			"day of month or day of week",
+			"0 0 13 * FRI",
+			"2025-06-01T00:00:00Z",
+			"",
+			"",
+			3,
+			[]string{"2025-06-06T00:00:00Z", "2025-06-13T00:00:00Z", "2025-06-20T00:00:00Z"},
+		},
+		{
+			"sunday as 7",
+			"0 0 * * 7",
+			"2025-07-08T00:00:00Z",
+			"",
+			"",
+			1,
+			[]string{"2025-07-13T00:00:00Z"},
+		},
+		{
+			"leap day",
+			"0 0 29 2 *",
+			"2025-01-01T00:00:00Z",
+			"",
+			"",
+			1,
+			[]string{"2028-02-29T00:00:00Z"},
+		},
+		{
+			"timezone",
+			"0 9 * * *",
+			"2025-07-08T12:00:00Z",
+			"Europe/Paris",
+			"",
+			1,
+			[]string{"2025-07-09T09:00:00+02:00"},
+		},
+		{
+			"DST gap runs at the end of the gap",
+			"30 2 * * *",
+			"2025-03-29T12:00:00",
+			"Europe/Paris",
+			"",
+			2,
+			[]string{"2025-03-30T03:00:00+02:00", "2025-03-31T02:30:00+02:00"},
+		},
+		{
+			"DST gap is deduplicated",
+			"*/20 * * * *",
+			"2025-03-30T01:30:00",
+			"Europe/Paris",
+			"",
+			4,
+			[]string{"2025-03-30T01:40:00+01:00", "2025-03-30T03:00:00+02:00", "2025-03-30T03:20:00+02:00", "2025-03-30T03:40:00+02:00"},
+		},
+		{
+			"DST overlap runs once",
+			"30 * * * *",
+			"2025-10-26T00:00:00",
+			"Europe/Paris",
+			"",
+			3,
+			[]string{"2025-10-26T00:30:00+02:00", "2025-10-26T01:30:00+02:00", "2025-10-26T02:30:00+02:00"},
+		},
+		{
+			"DST overlap from the repeated hour",
+			"0,30 * * * *",
+			"2025-10-26T01:10:00Z",
+			"Europe/Paris",
+			"",
+			2,
+			[]string{"2025-10-26T03:00:00+01:00", "2025-10-26T03:30:00+01:00"},
+		},
+		{
+			"DST overlap previous",
+			"0 * * * *",
+			"2025-10-26T04:00:00",
+			"Europe/Paris",
+			"previous",
+			3,
+			[]string{"2025-10-26T03:00:00+01:00", "2025-10-26T02:00:00+02:00", "2025-10-26T01:00:00+02:00"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			output, err := CronNext(test.expression, test.inputTime, test.timezone, test.direction, test.count, InputFormat{}, Format{Layout: "RFC3339"})
+			if err != nil {
+				t.Errorf("unexpected error %v", err)
+				return
+			}
+
+			if strings.Join(output, ", ") != strings.Join(test.expectedOutput, ", ") {
+				t.Errorf("expected output %q, got %q", test.expectedOutput, output)
+			}
+		})
+	}
+}
+
+// TestCronNextNoOccurrence tests that CronNext reports expressions which never match.
+func TestCronNextNoOccurrence(t *testing.T) {
+	_, err := CronNext("0 0 30 2 *", "2025-01-01T00:00:00Z", "", "", 1, InputFormat{}, Format{})
+	if err == nil || !strings.HasPrefix(err.Error(), "no_occurrence:") {
+		t.Errorf("expected no_occurrence error, got %v", err)
+	}
+}
@@ -0,0 +1,101 @@
+package datetime
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/TheoBrigitte/mcp-time/pkg/holidays"
+)
+
+// DateInfo lists calendar facts about a time.
+type DateInfo struct {
+	// Time is the time, in the requested timezone and format.
+	Time string `json:"time"`
+	// Timezone is the name of the timezone in which the facts are computed.
+	Timezone string `json:"timezone"`
+	// Date is the local date, in the YYYY-MM-DD format.
+	Date string `json:"date"`
+	// Year, Month and Day are the components of the local date.
+	Year  int `json:"year"`
+	Month int `json:"month"`
+	Day   int `json:"day"`
+	// MonthName is the English name of the month (e.g., "July").
+	MonthName string `json:"month_name"`
+	// Weekday is the English name of the day of the week (e.g., "Wednesday").
+	Weekday string `json:"weekday"`
+	// WeekdayNumber is the ISO 8601 number of the day of the week, from 1 (Monday) to 7 (Sunday).
+	WeekdayNumber int `json:"weekday_number"`
+	// ISOYear and ISOWeek are the ISO 8601 week-numbering year and week number. The ISO year differs from the
+	// calendar year for days of the first or last week of the year (e.g., 2024-12-30 is in week 1 of 2025).
+	ISOYear int `json:"iso_year"`
+	ISOWeek int `json:"iso_week"`
+	// ISOWeekDate is the ISO 8601 week date (e.g., "2025-W28-3").
+	ISOWeekDate string `json:"iso_week_date"`
+	// DayOfYear is the day of the year, from 1 to 366.
+	DayOfYear int `json:"day_of_year"`
+	// Quarter is the quarter of the year, from 1 to 4.
+	Quarter int `json:"quarter"`
+	// DaysInMonth and DaysInYear are the number of days in the month and year.
+	DaysInMonth int `json:"days_in_month"`
+	DaysInYear  int `json:"days_in_year"`
+	// IsLeapYear is set when the year has a February 29th.
+	IsLeapYear bool `json:"is_leap_year"`
+	// UnixTimestamp is the number of seconds elapsed since January 1st, 1970 UTC.
+	UnixTimestamp int64 `json:"unix_timestamp"`
+}
+
+// info returns the calendar facts of the dateTime, in its location.
+func (dt dateTime) info() *DateInfo {
+	t := dt.time
+	isoYear, isoWeek := t.ISOWeek()
+
+	// ISO 8601 numbers Sunday 7 instead of 0.
+	weekday := int(t.Weekday())
+	if weekday == 0 {
+		weekday = 7
+	}
+
+	return &DateInfo{
+		Timezone:      t.Location().String(),
+		Date:          t.Format(time.DateOnly),
+		Year:          t.Year(),
+		Month:         int(t.Month()),
+		Day:           t.Day(),
+		MonthName:     t.Month().String(),
+		Weekday:       t.Weekday().String(),
+		WeekdayNumber: weekday,
+		ISOYear:       isoYear,
+		ISOWeek:       isoWeek,
+		ISOWeekDate:   fmt.Sprintf("%04d-W%02d-%d", isoYear, isoWeek, weekday),
+		DayOfYear:     t.YearDay(),
+		Quarter:       (int(t.Month())-1)/3 + 1,
+		DaysInMonth:   holidays.DaysIn(t.Year(), t.Month()),
+		DaysInYear:    daysInYear(t.Year()),
+		IsLeapYear:    daysInYear(t.Year()) == 366,
+		UnixTimestamp: t.Unix(),
+	}
+}
+
+// GetDateInfo returns calendar facts about a time (ISO week, day of year, quarter, days in month, ...), computed on the
+// wall clock of the given timezone. Input times without timezone are interpreted in that timezone, and the time is
+// returned in the timezone and the specified format.
+func GetDateInfo(inputTime, timezone string, inputFormat InputFormat, format Format) (*DateInfo, error) {
+	location, err := ResolveTimezone(timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	dt, err := fromStringWithTimezone(inputTime, inputFormat, timezone, "time")
+	if err != nil {
+		return nil, err
+	}
+	dt.time = dt.time.In(location)
+
+	info := dt.info()
+	info.Time, err = dt.format(format, timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
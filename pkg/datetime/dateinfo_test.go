@@ -0,0 +1,79 @@
+package datetime
+
+import (
+	"testing"
+)
+
+// TestGetDateInfo tests the GetDateInfo function.
+func TestGetDateInfo(t *testing.T) {
+	tests := []struct {
+		name     string
+		time     string
+		timezone string
+		expected DateInfo
+	}{
+		{
+			name:     "summer day",
+			time:     "2025-07-09T12:00:00Z",
+			timezone: "Europe/Paris",
+			expected: DateInfo{
+				Time: "2025-07-09T14:00:00+02:00", Timezone: "Europe/Paris", Date: "2025-07-09", Year: 2025, Month: 7, Day: 9,
+				MonthName: "July", Weekday: "Wednesday", WeekdayNumber: 3, ISOYear: 2025, ISOWeek: 28, ISOWeekDate: "2025-W28-3",
+				DayOfYear: 190, Quarter: 3, DaysInMonth: 31, DaysInYear: 365, IsLeapYear: false, UnixTimestamp: 1752062400,
+			},
+		},
+		{
+			name:     "iso year after calendar year",
+			time:     "2024-12-30 10:00",
+			timezone: "UTC",
+			expected: DateInfo{
+				Time: "2024-12-30T10:00:00Z", Timezone: "UTC", Date: "2024-12-30", Year: 2024, Month: 12, Day: 30,
+				MonthName: "December", Weekday: "Monday", WeekdayNumber: 1, ISOYear: 2025, ISOWeek: 1, ISOWeekDate: "2025-W01-1",
+				DayOfYear: 365, Quarter: 4, DaysInMonth: 31, DaysInYear: 366, IsLeapYear: true, UnixTimestamp: 1735552800,
+			},
+		},
+		{
+			name:     "iso year before calendar year",
+			time:     "2027-01-03T23:30:00Z",
+			timezone: "Asia/Tokyo",
+			expected: DateInfo{
+				Time: "2027-01-04T08:30:00+09:00", Timezone: "Asia/Tokyo", Date: "2027-01-04", Year: 2027, Month: 1, Day: 4,
+				MonthName: "January", Weekday: "Monday", WeekdayNumber: 1, ISOYear: 2027, ISOWeek: 1, ISOWeekDate: "2027-W01-1",
+				DayOfYear: 4, Quarter: 1, DaysInMonth: 31, DaysInYear: 365, IsLeapYear: false, UnixTimestamp: 1799019000,
+			},
+		},
+		{
+			name:     "sunday in week 53",
+			time:     "2021-01-03",
+			timezone: "",
+			expected: DateInfo{
+				Time: "2021-01-03T00:00:00Z", Timezone: "UTC", Date: "2021-01-03", Year: 2021, Month: 1, Day: 3,
+				MonthName: "January", Weekday: "Sunday", WeekdayNumber: 7, ISOYear: 2020, ISOWeek: 53, ISOWeekDate: "2020-W53-7",
+				DayOfYear: 3, Quarter: 1, DaysInMonth: 31, DaysInYear: 365, IsLeapYear: false, UnixTimestamp: 1609632000,
+			},
+		},
+		{
+			name:     "leap day",
+			time:     "2028-02-29 12:00",
+			timezone: "America/New_York",
+			expected: DateInfo{
+				Time: "2028-02-29T12:00:00-05:00", Timezone: "America/New_York", Date: "2028-02-29", Year: 2028, Month: 2, Day: 29,
+				MonthName: "February", Weekday: "Tuesday", WeekdayNumber: 2, ISOYear: 2028, ISOWeek: 9, ISOWeekDate: "2028-W09-2",
+				DayOfYear: 60, Quarter: 1, DaysInMonth: 29, DaysInYear: 366, IsLeapYear: true, UnixTimestamp: 1835456400,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			info, err := GetDateInfo(test.time, test.timezone, InputFormat{}, Format{Layout: "RFC3339"})
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+
+			if *info != test.expected {
+				t.Errorf("expected %+v, got %+v", test.expected, *info)
+			}
+		})
+	}
+}
@@ -1,32 +1,35 @@
 package datetime
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
-	"github.com/tj/go-naturaldate"
+	"github.com/TheoBrigitte/mcp-time/pkg/relative"
 )
 
 // CurrentTime returns the current time in the specified timezone and format.
-func CurrentTime(timezone, format string) (output string, err error) {
+func CurrentTime(timezone string, format Format) (output string, err error) {
 	return fromTime(time.Now()).
 		format(format, timezone)
 }
 
 // ConvertTime converts a given time string from one timezone to another.
 // If inputTimezone is empty, UTC is used as the default.
-func ConvertTime(inputTime, inputTimezone, outputTimezone, format string) (output string, err error) {
+// The input time is read in the input format (e.g., "UnixMilli"), or its format is guessed if the input format is empty.
+func ConvertTime(inputTime, inputTimezone, outputTimezone string, inputFormat InputFormat, format Format) (output string, err error) {
 	// Default to UTC if no input timezone is specified.
 	var inputLocation = defaultLocation
 	if inputTimezone != "" {
-		// Load the input timezone location from the IANA timezone database.
-		inputLocation, err = time.LoadLocation(inputTimezone)
+		// Resolve the input timezone location.
+		inputLocation, err = ResolveTimezone(inputTimezone)
 		if err != nil {
-			return "", fmt.Errorf("invalid_timezone: Invalid IANA input timezone name: %s", inputTimezone)
+			return "", err
 		}
 	}
 
-	dt, err := fromStringWithLocation(inputTime, inputLocation)
+	dt, err := fromStringWithFormat(inputTime, inputFormat, inputLocation)
 	if err != nil {
 		return "", err
 	}
@@ -35,38 +38,71 @@ func ConvertTime(inputTime, inputTimezone, outputTimezone, format string) (outpu
 }
 
 // TimeAdd adds a duration to a given time string and returns the result in the specified timezone and format.
-func TimeAdd(inputTime, duration, timezone, format string) (output string, err error) {
-	dt, err := fromString(inputTime)
+// The duration accepts calendar units (e.g., "1 month", "2w", "1y2mo3d4h"), which are applied on the wall clock
+// of the output timezone. See addDate for the handling of month-end overflow.
+func TimeAdd(inputTime, duration, timezone string, inputFormat InputFormat, format Format) (output string, err error) {
+	dt, err := fromString(inputTime, inputFormat)
 	if err != nil {
 		return "", err
 	}
 
-	// Parse the duration string (e.g., "2h30m").
-	d, err := time.ParseDuration(duration)
+	// Parse the duration string (e.g., "2h30m", "1 month").
+	d, err := parseDuration(duration)
 	if err != nil {
 		return "", fmt.Errorf("invalid_duration: Invalid duration format: %s", duration)
 	}
 
-	dt.time = dt.time.Add(d)
+	// Apply calendar units in the output timezone, so that "1d" keeps the local time of day across DST changes.
+	if timezone != "" {
+		location, err := ResolveTimezone(timezone)
+		if err != nil {
+			return "", err
+		}
+		dt.time = dt.time.In(location)
+	}
+
+	dt.time = d.addTo(dt.time)
 
 	return dt.format(format, timezone)
 }
 
+// GetRelativeLanguages returns the codes of the languages understood by RelativeTime.
+func GetRelativeLanguages() []string { return relative.Languages() }
+
 // RelativeTime parses a relative time string (e.g., "2 hours ago") based on a reference time.
-func RelativeTime(inputTime, relativeTime, timezone, format string) (output string, err error) {
-	refTime, err := fromString(inputTime)
+// The language selects the grammar of the expression (e.g., "fr" for "hier à 10h"), expressions which are not
+// understood are parsed as English. If language is empty, English is used.
+func RelativeTime(inputTime, relativeTime, language, timezone string, inputFormat InputFormat, format Format) (output string, err error) {
+	refTime, err := fromString(inputTime, inputFormat)
 	if err != nil {
 		return "", err
 	}
 
-	// Parse the natural language relative time string.
-	t, err := naturaldate.Parse(relativeTime, refTime.time)
+	parser := relative.English
+	if language != "" {
+		var ok bool
+		parser, ok = relative.Lookup(language)
+		if !ok {
+			return "", fmt.Errorf("invalid_language: Unknown language: %s (available: %s)", language, strings.Join(relative.Languages(), ", "))
+		}
+	}
+
+	// Parse the natural language relative time string, falling back to English for expressions which are not
+	// understood. Expressions which are understood but invalid (e.g., "um 25 Uhr") are reported as is.
+	t, err := parser.Parse(relativeTime, refTime.time)
+	if err != nil && parser != relative.English {
+		if !errors.Is(err, relative.ErrUnknownExpression) {
+			return "", fmt.Errorf("invalid_relative_time: Invalid relative time: %s: %s", relativeTime, err)
+		}
+		t, err = relative.English.Parse(relativeTime, refTime.time)
+	}
 	if err != nil {
 		return "", fmt.Errorf("invalid_relative_time: Unable to parse relative time: %s", relativeTime)
 	}
 
 	dt := fromTime(t)
-	dt.inputTime = inputTime // Store the original input time for format parsing.
+	// Store the original input time and its layout for format parsing.
+	dt.inputTime, dt.layout = refTime.inputTime, refTime.layout
 
 	return dt.format(format, timezone)
 }
@@ -75,33 +111,33 @@ func RelativeTime(inputTime, relativeTime, timezone, format string) (output stri
 //   - -1 if timeA is before timeB
 //   - 0 if timeA is equal to timeB
 //   - 1 if timeA is after timeB
-func CompareTime(timeA, timeB, timeATimezone, timeBTimezone string) (int, error) {
-	var err error
-	var timeALocation = defaultLocation
-	if timeATimezone != "" {
-		// Load the input timezone location from the IANA timezone database.
-		timeALocation, err = time.LoadLocation(timeATimezone)
-		if err != nil {
-			return -2, fmt.Errorf("invalid_timezone: Invalid IANA input timezone name for time_a: %s", timeATimezone)
-		}
+func CompareTime(timeA, timeB, timeATimezone, timeBTimezone string, inputFormat InputFormat) (int, error) {
+	ta, err := fromStringWithTimezone(timeA, inputFormat, timeATimezone, "time_a")
+	if err != nil {
+		return -2, err
 	}
-	ta, err := fromStringWithLocation(timeA, timeALocation)
+
+	tb, err := fromStringWithTimezone(timeB, inputFormat, timeBTimezone, "time_b")
 	if err != nil {
-		return -2, fmt.Errorf("invalid_time: invalid format for time_a: %q", timeA)
+		return -2, err
 	}
 
-	var timeBLocation = defaultLocation
-	if timeBTimezone != "" {
-		// Load the input timezone location from the IANA timezone database.
-		timeBLocation, err = time.LoadLocation(timeBTimezone)
-		if err != nil {
-			return -2, fmt.Errorf("invalid_timezone: Invalid IANA input timezone name for time_b: %s", timeBTimezone)
-		}
+	return ta.time.Compare(tb.time), nil
+}
+
+// TimeDifference computes the signed difference between two time strings (timeB - timeA).
+// The result is positive when timeB is after timeA.
+// The calendar breakdown is computed using the wall clock of timeA.
+func TimeDifference(timeA, timeB, timeATimezone, timeBTimezone string, inputFormat InputFormat) (*Difference, error) {
+	ta, err := fromStringWithTimezone(timeA, inputFormat, timeATimezone, "time_a")
+	if err != nil {
+		return nil, err
 	}
-	tb, err := fromStringWithLocation(timeB, timeBLocation)
+
+	tb, err := fromStringWithTimezone(timeB, inputFormat, timeBTimezone, "time_b")
 	if err != nil {
-		return -2, fmt.Errorf("invalid_time: invalid format for time_b: %q", timeB)
+		return nil, err
 	}
 
-	return ta.time.Compare(tb.time), nil
+	return newDifference(ta.time, tb.time), nil
 }
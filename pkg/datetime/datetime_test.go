@@ -1,6 +1,7 @@
 package datetime
 
 import (
+	"strings"
 	"testing"
 	"time"
 )
@@ -25,7 +26,7 @@ func TestFromString(t *testing.T) {
 	inputTime := "2025-06-07T12:34:56Z"
 	expectedTime := time.Date(2025, 6, 7, 12, 34, 56, 00, time.UTC)
 
-	dt, err := fromString(inputTime)
+	dt, err := fromString(inputTime, InputFormat{})
 	if err != nil {
 		t.Errorf("unexpected error %v", err)
 		return
@@ -114,7 +115,7 @@ func TestFormat(t *testing.T) {
 	//}
 
 	mustFromString := func(inputTime string) *dateTime {
-		dt, err := fromString(inputTime)
+		dt, err := fromString(inputTime, InputFormat{})
 		if err != nil {
 			panic(err)
 		}
@@ -195,7 +196,7 @@ func TestFormat(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			output, err := test.dateTime.format(test.format, test.timezone)
+			output, err := test.dateTime.format(Format{Layout: test.format}, test.timezone)
 			if err != nil {
 				t.Errorf("unexpected error %v", err)
 				return
@@ -208,6 +209,44 @@ func TestFormat(t *testing.T) {
 	}
 }
 
+// TestFormatLocale tests the format method with a locale.
+func TestFormatLocale(t *testing.T) {
+	dt := fromTime(time.Date(2025, 6, 7, 12, 34, 56, 00, time.UTC))
+
+	tests := []struct {
+		format         Format
+		timezone       string
+		expectedOutput string
+	}{
+		{Format{Locale: "fr"}, "Europe/Paris", "7 juin 2025 14:34:56"},
+		{Format{Layout: "DateFull", Locale: "fr-FR"}, "", "samedi 7 juin 2025"},
+		{Format{Layout: "Mon 2 Jan 2006 15:04 MST", Locale: "de"}, "Europe/Berlin", "Sa. 7 Juni 2025 14:34 CEST"},
+		{Format{Layout: "DateTimeLong", Locale: "ja"}, "Asia/Tokyo", "2025年6月7日 21:34:56"},
+		{Format{Layout: "RFC1123", Locale: "es"}, "", "sáb, 07 jun 2025 12:34:56 UTC"},
+		// Pattern names are written in English without a locale.
+		{Format{Layout: "DateTimeShort"}, "", "6/7/25, 12:34 PM"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.format.Layout+" "+test.format.Locale, func(t *testing.T) {
+			output, err := dt.format(test.format, test.timezone)
+			if err != nil {
+				t.Errorf("unexpected error %v", err)
+				return
+			}
+
+			if output != test.expectedOutput {
+				t.Errorf("expected output %q, got %q", test.expectedOutput, output)
+			}
+		})
+	}
+
+	_, err := dt.format(Format{Locale: "xx"}, "")
+	if err == nil || !strings.HasPrefix(err.Error(), "invalid_locale:") {
+		t.Errorf("expected invalid_locale error, got %v", err)
+	}
+}
+
 // TestConvertTime tests the ConvertTime function.
 func TestConvertTime(t *testing.T) {
 	tests := []struct {
@@ -254,7 +293,7 @@ func TestConvertTime(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			output, err := ConvertTime(test.inputTime, test.inputTimezone, test.outputTimezone, test.outputFormat)
+			output, err := ConvertTime(test.inputTime, test.inputTimezone, test.outputTimezone, InputFormat{}, Format{Layout: test.outputFormat})
 			if err != nil {
 				t.Errorf("unexpected error %v", err)
 				return
@@ -273,25 +312,98 @@ func TestTimeAdd(t *testing.T) {
 		name           string
 		inputTime      string
 		duration       string
+		timezone       string
 		expectedOutput string
 	}{
 		{
 			"+1h",
 			"2025-07-08T12:34:56Z",
 			"1h",
+			"",
 			"2025-07-08T13:34:56Z",
 		},
 		{
 			"-1h",
 			"2025-07-08T12:34:56Z",
 			"-1h",
+			"",
 			"2025-07-08T11:34:56Z",
 		},
+		{
+			"+1d",
+			"2025-07-08T12:34:56Z",
+			"1d",
+			"",
+			"2025-07-09T12:34:56Z",
+		},
+		{
+			"+2w",
+			"2025-07-08T12:34:56Z",
+			"2w",
+			"",
+			"2025-07-22T12:34:56Z",
+		},
+		{
+			"+1 month",
+			"2025-07-08T12:34:56Z",
+			"1 month",
+			"",
+			"2025-08-08T12:34:56Z",
+		},
+		{
+			"end of month clamped",
+			"2025-01-31T12:34:56Z",
+			"1mo",
+			"",
+			"2025-02-28T12:34:56Z",
+		},
+		{
+			"end of month clamped on leap year",
+			"2024-01-31T12:34:56Z",
+			"1mo",
+			"",
+			"2024-02-29T12:34:56Z",
+		},
+		{
+			"leap day +1y",
+			"2024-02-29T12:34:56Z",
+			"1y",
+			"",
+			"2025-02-28T12:34:56Z",
+		},
+		{
+			"-1 month",
+			"2025-03-31T12:34:56Z",
+			"-1 month",
+			"",
+			"2025-02-28T12:34:56Z",
+		},
+		{
+			"combined units",
+			"2025-07-08T12:34:56Z",
+			"1y2mo3d4h",
+			"",
+			"2026-09-11T16:34:56Z",
+		},
+		{
+			"+1d across DST change",
+			"2025-03-08T12:00:00-05:00",
+			"1d",
+			"America/New_York",
+			"2025-03-09T12:00:00-04:00",
+		},
+		{
+			"+24h across DST change",
+			"2025-03-08T12:00:00-05:00",
+			"24h",
+			"America/New_York",
+			"2025-03-09T13:00:00-04:00",
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			output, err := TimeAdd(test.inputTime, test.duration, "", "")
+			output, err := TimeAdd(test.inputTime, test.duration, test.timezone, InputFormat{}, Format{})
 			if err != nil {
 				t.Errorf("unexpected error %v", err)
 				return
@@ -400,7 +512,36 @@ func TestRelativeTime(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			output, err := RelativeTime(test.inputTime, test.relativeTime, "", "")
+			output, err := RelativeTime(test.inputTime, test.relativeTime, "", "", InputFormat{}, Format{})
+			if err != nil {
+				t.Errorf("unexpected error %v", err)
+				return
+			}
+
+			if output != test.expectedOutput {
+				t.Errorf("expected output %q, got %q", test.expectedOutput, output)
+			}
+		})
+	}
+}
+
+// TestRelativeTimeLanguage tests the RelativeTime function with other languages.
+func TestRelativeTimeLanguage(t *testing.T) {
+	tests := []struct {
+		language       string
+		relativeTime   string
+		expectedOutput string
+	}{
+		{"fr", "hier à 10h", "2025-07-07T10:00:00+02:00"},
+		{"de-DE", "nächsten Montag", "2025-07-14T00:00:00+02:00"},
+		{"es", "hace 2 horas", "2025-07-08T10:34:56+02:00"},
+		// Expressions which are not understood are parsed as English.
+		{"fr", "yesterday", "2025-07-07T00:00:00+02:00"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.language+" "+test.relativeTime, func(t *testing.T) {
+			output, err := RelativeTime("2025-07-08T12:34:56+02:00", test.relativeTime, test.language, "", InputFormat{}, Format{})
 			if err != nil {
 				t.Errorf("unexpected error %v", err)
 				return
@@ -411,6 +552,23 @@ func TestRelativeTime(t *testing.T) {
 			}
 		})
 	}
+
+	_, err := RelativeTime("", "hier", "xx", "", InputFormat{}, Format{})
+	if err == nil || !strings.HasPrefix(err.Error(), "invalid_language:") {
+		t.Errorf("expected invalid_language error, got %v", err)
+	}
+
+	// Expressions which are understood but invalid are not parsed as English.
+	for _, test := range []struct{ language, relativeTime string }{
+		{"fr", "n'importe quoi"},
+		{"de", "um 25 Uhr"},
+		{"fr", "dans 99999999999 heures"},
+	} {
+		_, err = RelativeTime("", test.relativeTime, test.language, "", InputFormat{}, Format{})
+		if err == nil || !strings.HasPrefix(err.Error(), "invalid_relative_time:") {
+			t.Errorf("expected invalid_relative_time error for %q, got %v", test.relativeTime, err)
+		}
+	}
 }
 
 // TestCompareTime tests the CompareTime function.
@@ -515,7 +673,7 @@ func TestCompareTime(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			result, err := CompareTime(test.timeA, test.timeB, test.timeATimezone, test.timeBTimezone)
+			result, err := CompareTime(test.timeA, test.timeB, test.timeATimezone, test.timeBTimezone, InputFormat{})
 			if err != nil {
 				t.Errorf("unexpected error %v", err)
 				return
@@ -527,3 +685,438 @@ func TestCompareTime(t *testing.T) {
 		})
 	}
 }
+
+// TestTimeDifference tests the TimeDifference function.
+func TestTimeDifference(t *testing.T) {
+	tests := []struct {
+		name             string
+		timeA            string
+		timeATimezone    string
+		timeB            string
+		timeBTimezone    string
+		expectedDuration string
+		expectedSeconds  float64
+		expectedCalendar CalendarDifference
+		expectedISO8601  string
+	}{
+		{
+			"equal",
+			"2025-07-08T12:34:56Z",
+			"",
+			"2025-07-08T12:34:56Z",
+			"",
+			"0s",
+			0,
+			CalendarDifference{},
+			"PT0S",
+		},
+		{
+			"hours and minutes",
+			"2025-07-08T10:00:00Z",
+			"",
+			"2025-07-09T12:03:00Z",
+			"",
+			"26h3m0s",
+			93780,
+			CalendarDifference{Days: 1, Hours: 2, Minutes: 3},
+			"P1DT2H3M",
+		},
+		{
+			"negative",
+			"2025-07-09T12:03:00Z",
+			"",
+			"2025-07-08T10:00:00Z",
+			"",
+			"-26h3m0s",
+			-93780,
+			CalendarDifference{Days: -1, Hours: -2, Minutes: -3},
+			"-P1DT2H3M",
+		},
+		{
+			"years and months",
+			"2023-01-15T00:00:00Z",
+			"",
+			"2025-03-20T06:00:30.5Z",
+			"",
+			"19086h0m30.5s",
+			68709630.5,
+			CalendarDifference{Years: 2, Months: 2, Days: 5, Hours: 6, Seconds: 30.5},
+			"P2Y2M5DT6H30.5S",
+		},
+		{
+			"end of month",
+			"2025-01-31T00:00:00Z",
+			"",
+			"2025-03-01T00:00:00Z",
+			"",
+			"696h0m0s",
+			2505600,
+			CalendarDifference{Months: 1, Days: 1},
+			"P1M1D",
+		},
+		{
+			"with timezones",
+			"2025-07-08T12:00:00",
+			"America/New_York",
+			"2025-07-08T18:00:00",
+			"Europe/Paris",
+			"0s",
+			0,
+			CalendarDifference{},
+			"PT0S",
+		},
+		{
+			"across DST change",
+			"2025-03-08T12:00:00",
+			"America/New_York",
+			"2025-03-09T12:00:00",
+			"America/New_York",
+			"23h0m0s",
+			82800,
+			CalendarDifference{Days: 1},
+			"P1D",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := TimeDifference(test.timeA, test.timeB, test.timeATimezone, test.timeBTimezone, InputFormat{})
+			if err != nil {
+				t.Errorf("unexpected error %v", err)
+				return
+			}
+
+			if result.Duration != test.expectedDuration {
+				t.Errorf("expected duration %q, got %q", test.expectedDuration, result.Duration)
+			}
+
+			if result.TotalSeconds != test.expectedSeconds {
+				t.Errorf("expected total seconds %v, got %v", test.expectedSeconds, result.TotalSeconds)
+			}
+
+			if result.Calendar != test.expectedCalendar {
+				t.Errorf("expected calendar difference %+v, got %+v", test.expectedCalendar, result.Calendar)
+			}
+
+			if result.ISO8601 != test.expectedISO8601 {
+				t.Errorf("expected ISO 8601 duration %q, got %q", test.expectedISO8601, result.ISO8601)
+			}
+		})
+	}
+}
+
+// TestAddBusinessDays tests the AddBusinessDays function.
+func TestAddBusinessDays(t *testing.T) {
+	tests := []struct {
+		name           string
+		inputTime      string
+		days           int
+		weekend        []string
+		country        string
+		timezone       string
+		expectedOutput string
+	}{
+		{
+			"same week",
+			"2025-07-08T12:34:56Z",
+			2,
+			nil,
+			"",
+			"",
+			"2025-07-10T12:34:56Z",
+		},
+		{
+			"over weekend",
+			"2025-07-10T12:34:56Z",
+			5,
+			nil,
+			"",
+			"",
+			"2025-07-17T12:34:56Z",
+		},
+		{
+			"backward over weekend",
+			"2025-07-14T12:34:56Z",
+			-1,
+			nil,
+			"",
+			"",
+			"2025-07-11T12:34:56Z",
+		},
+		{
+			"zero on weekend",
+			"2025-07-12T12:34:56Z",
+			0,
+			nil,
+			"",
+			"",
+			"2025-07-14T12:34:56Z",
+		},
+		{
+			"from weekend",
+			"2025-07-12T12:34:56Z",
+			1,
+			nil,
+			"",
+			"",
+			"2025-07-14T12:34:56Z",
+		},
+		{
+			"custom weekend",
+			"2025-07-10T12:00:00Z",
+			1,
+			[]string{"Friday", "sat"},
+			"",
+			"",
+			"2025-07-13T12:00:00Z",
+		},
+		{
+			"no weekend",
+			"2025-07-11T12:00:00Z",
+			1,
+			[]string{},
+			"",
+			"",
+			"2025-07-12T12:00:00Z",
+		},
+		{
+			"holiday",
+			"2025-12-24T12:00:00Z",
+			1,
+			nil,
+			"US",
+			"",
+			"2025-12-26T12:00:00Z",
+		},
+		{
+			"observed holiday",
+			"2026-07-02T12:00:00Z",
+			1,
+			nil,
+			"US",
+			"",
+			"2026-07-06T12:00:00Z",
+		},
+		{
+			"timezone",
+			"2025-07-11T23:00:00",
+			1,
+			nil,
+			"",
+			"Asia/Tokyo",
+			"2025-07-14T23:00:00",
+		},
+		{
+			"timezone changes the day",
+			"2025-07-11T23:00:00+00:00",
+			1,
+			nil,
+			"",
+			"Asia/Tokyo",
+			"2025-07-14T08:00:00+09:00",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			output, err := AddBusinessDays(test.inputTime, test.days, test.weekend, test.country, test.timezone, InputFormat{}, Format{})
+			if err != nil {
+				t.Errorf("unexpected error %v", err)
+				return
+			}
+
+			if output != test.expectedOutput {
+				t.Errorf("expected output %q, got %q", test.expectedOutput, output)
+			}
+		})
+	}
+}
+
+// TestAddBusinessDaysInvalidDays tests that AddBusinessDays rejects too many days.
+func TestAddBusinessDaysInvalidDays(t *testing.T) {
+	for _, days := range []int{maxBusinessDays + 1, -maxBusinessDays - 1} {
+		_, err := AddBusinessDays("2025-07-08T12:00:00Z", days, nil, "", "", InputFormat{}, Format{})
+		if err == nil || !strings.HasPrefix(err.Error(), "invalid_days:") {
+			t.Errorf("expected invalid_days error for %d, got %v", days, err)
+		}
+	}
+}
+
+// TestCountBusinessDays tests the CountBusinessDays function.
+func TestCountBusinessDays(t *testing.T) {
+	tests := []struct {
+		name           string
+		startTime      string
+		endTime        string
+		weekend        []string
+		country        string
+		timezone       string
+		expectedResult int
+	}{
+		{
+			"same day",
+			"2025-07-08T08:00:00Z",
+			"2025-07-08T18:00:00Z",
+			nil,
+			"",
+			"",
+			0,
+		},
+		{
+			"one week",
+			"2025-07-07T00:00:00Z",
+			"2025-07-14T00:00:00Z",
+			nil,
+			"",
+			"",
+			5,
+		},
+		{
+			"several weeks",
+			"2025-07-01T00:00:00Z",
+			"2025-08-01T00:00:00Z",
+			nil,
+			"",
+			"",
+			23,
+		},
+		{
+			"negative",
+			"2025-07-14T00:00:00Z",
+			"2025-07-07T00:00:00Z",
+			nil,
+			"",
+			"",
+			-5,
+		},
+		{
+			"custom weekend",
+			"2025-07-07T00:00:00Z",
+			"2025-07-14T00:00:00Z",
+			[]string{"Friday", "Saturday"},
+			"",
+			"",
+			5,
+		},
+		{
+			"holidays",
+			"2025-12-01T00:00:00Z",
+			"2026-01-01T00:00:00Z",
+			nil,
+			"GB",
+			"",
+			21,
+		},
+		{
+			"holidays over several years",
+			"2024-01-01T00:00:00Z",
+			"2026-01-01T00:00:00Z",
+			nil,
+			"FR",
+			"",
+			503,
+		},
+		{
+			"timezone",
+			"2025-07-11T20:00:00Z",
+			"2025-07-14T00:00:00Z",
+			nil,
+			"",
+			"Asia/Tokyo",
+			0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := CountBusinessDays(test.startTime, test.endTime, test.weekend, test.country, test.timezone, InputFormat{})
+			if err != nil {
+				t.Errorf("unexpected error %v", err)
+				return
+			}
+
+			if result != test.expectedResult {
+				t.Errorf("expected result %d, got %d", test.expectedResult, result)
+			}
+		})
+	}
+}
+
+// TestIsHoliday tests the IsHoliday function.
+func TestIsHoliday(t *testing.T) {
+	tests := []struct {
+		name             string
+		inputTime        string
+		country          string
+		timezone         string
+		expectedDate     string
+		expectedHolidays []string
+	}{
+		{
+			"holiday",
+			"2025-07-04T12:00:00Z",
+			"US",
+			"",
+			"2025-07-04",
+			[]string{"Independence Day"},
+		},
+		{
+			"not a holiday",
+			"2025-07-08T12:00:00Z",
+			"us",
+			"",
+			"2025-07-08",
+			nil,
+		},
+		{
+			"easter",
+			"2025-04-21",
+			"FR",
+			"",
+			"2025-04-21",
+			[]string{"Lundi de Pâques"},
+		},
+		{
+			"timezone",
+			"2025-12-24T23:30:00Z",
+			"DE",
+			"Europe/Berlin",
+			"2025-12-25",
+			[]string{"Erster Weihnachtstag"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := IsHoliday(test.inputTime, test.country, test.timezone, InputFormat{})
+			if err != nil {
+				t.Errorf("unexpected error %v", err)
+				return
+			}
+
+			if result.Date != test.expectedDate {
+				t.Errorf("expected date %q, got %q", test.expectedDate, result.Date)
+			}
+
+			if result.IsHoliday != (len(test.expectedHolidays) > 0) {
+				t.Errorf("expected is holiday %v, got %v", len(test.expectedHolidays) > 0, result.IsHoliday)
+			}
+
+			var names []string
+			for _, h := range result.Holidays {
+				names = append(names, h.Name)
+			}
+			if strings.Join(names, ", ") != strings.Join(test.expectedHolidays, ", ") {
+				t.Errorf("expected holidays %v, got %v", test.expectedHolidays, names)
+			}
+		})
+	}
+}
+
+// TestIsHolidayInvalidCountry tests that IsHoliday rejects unknown countries.
+func TestIsHolidayInvalidCountry(t *testing.T) {
+	_, err := IsHoliday("2025-07-04", "XX", "", InputFormat{})
+	if err == nil || !strings.HasPrefix(err.Error(), "invalid_country:") {
+		t.Errorf("expected invalid_country error, got %v", err)
+	}
+}
@@ -0,0 +1,98 @@
+package datetime
+
+import (
+	"math"
+	"time"
+)
+
+// Difference describes the signed difference between two times.
+type Difference struct {
+	// Duration is the difference as a Go duration string (e.g., "26h3m0s").
+	Duration string `json:"duration"`
+	// TotalSeconds is the difference expressed in seconds.
+	TotalSeconds float64 `json:"total_seconds"`
+	// Calendar is the difference broken down into calendar units.
+	Calendar CalendarDifference `json:"calendar"`
+	// ISO8601 is the calendar breakdown as an ISO 8601 duration (e.g., "P1DT2H3M").
+	ISO8601 string `json:"iso8601"`
+}
+
+// CalendarDifference is a difference broken down into calendar units.
+// All fields carry the sign of the difference.
+type CalendarDifference struct {
+	Years   int     `json:"years"`
+	Months  int     `json:"months"`
+	Days    int     `json:"days"`
+	Hours   int     `json:"hours"`
+	Minutes int     `json:"minutes"`
+	Seconds float64 `json:"seconds"`
+}
+
+// newDifference computes the difference b - a.
+func newDifference(a, b time.Time) *Difference {
+	d := b.Sub(a)
+	calendar := calendarDifference(a, b)
+
+	return &Difference{
+		Duration:     d.String(),
+		TotalSeconds: d.Seconds(),
+		Calendar:     calendar,
+		ISO8601:      calendar.duration().iso8601(),
+	}
+}
+
+// duration converts the calendar difference to a calendarDuration.
+func (c CalendarDifference) duration() calendarDuration {
+	return calendarDuration{
+		years:  c.Years,
+		months: c.Months,
+		days:   c.Days,
+		clock:  time.Duration(c.Hours)*time.Hour + time.Duration(c.Minutes)*time.Minute + time.Duration(math.Round(c.Seconds*float64(time.Second))),
+	}
+}
+
+// calendarDifference breaks down the difference b - a into calendar units.
+// Whole months are counted first using addDate, then whole days, and the remainder is split into
+// hours, minutes and seconds of elapsed time. Both times are compared using the wall clock of a.
+func calendarDifference(a, b time.Time) CalendarDifference {
+	b = b.In(a.Location())
+
+	sign := 1
+	start, end := a, b
+	if end.Before(start) {
+		sign = -1
+		start, end = end, start
+	}
+
+	// Count whole months, the estimate can overshoot by one.
+	months := (end.Year()-start.Year())*12 + int(end.Month()-start.Month())
+	for months > 0 && addDate(start, 0, months, 0).After(end) {
+		months--
+	}
+	anchor := addDate(start, 0, months, 0)
+
+	// Count whole days, starting from an estimate based on elapsed time.
+	days := int(end.Sub(anchor) / (24 * time.Hour))
+	for days > 0 && anchor.AddDate(0, 0, days).After(end) {
+		days--
+	}
+	for !anchor.AddDate(0, 0, days+1).After(end) {
+		days++
+	}
+	anchor = anchor.AddDate(0, 0, days)
+
+	rest := end.Sub(anchor)
+	hours := rest / time.Hour
+	rest -= hours * time.Hour
+	minutes := rest / time.Minute
+	rest -= minutes * time.Minute
+
+	return CalendarDifference{
+		Years:   sign * (months / 12),
+		Months:  sign * (months % 12),
+		Days:    sign * days,
+		Hours:   sign * int(hours),
+		Minutes: sign * int(minutes),
+		Seconds: (time.Duration(sign) * rest).Seconds(),
+	}
+}
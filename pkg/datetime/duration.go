@@ -0,0 +1,329 @@
+package datetime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// calendarDuration is a duration made of calendar units and elapsed time.
+// Calendar units (years, months and days) are applied on the wall clock using addDate,
+// while the clock part is an exact elapsed time.
+type calendarDuration struct {
+	years, months, days int
+	clock               time.Duration
+}
+
+// addTo returns t plus the duration. Calendar units are applied first, then the clock part.
+func (d calendarDuration) addTo(t time.Time) time.Time {
+	return addDate(t, d.years, d.months, d.days).Add(d.clock)
+}
+
+// durationUnit describes how a unit contributes to a calendarDuration.
+type durationUnit struct {
+	// calendar is set for units which are applied on the wall clock.
+	calendar func(d *calendarDuration, n int)
+	// clock is the elapsed time of one unit, for units which are applied as elapsed time.
+	clock time.Duration
+}
+
+// durationUnits maps unit names to their definition.
+var durationUnits = map[string]durationUnit{}
+
+func init() {
+	register := func(unit durationUnit, names ...string) {
+		for _, name := range names {
+			durationUnits[name] = unit
+		}
+	}
+
+	register(durationUnit{calendar: func(d *calendarDuration, n int) { d.years += n }}, "y", "yr", "yrs", "year", "years")
+	register(durationUnit{calendar: func(d *calendarDuration, n int) { d.months += n }}, "mo", "mos", "month", "months")
+	register(durationUnit{calendar: func(d *calendarDuration, n int) { d.days += 7 * n }}, "w", "wk", "wks", "week", "weeks")
+	register(durationUnit{calendar: func(d *calendarDuration, n int) { d.days += n }}, "d", "day", "days")
+	register(durationUnit{clock: time.Hour}, "h", "hr", "hrs", "hour", "hours")
+	register(durationUnit{clock: time.Minute}, "m", "min", "mins", "minute", "minutes")
+	register(durationUnit{clock: time.Second}, "s", "sec", "secs", "second", "seconds")
+	register(durationUnit{clock: time.Millisecond}, "ms", "millisecond", "milliseconds")
+	register(durationUnit{clock: time.Microsecond}, "us", "µs", "μs", "microsecond", "microseconds")
+	register(durationUnit{clock: time.Nanosecond}, "ns", "nanosecond", "nanoseconds")
+}
+
+// parseDuration parses a duration string.
+//
+// In addition to the Go duration format (e.g., "2h30m"), it accepts calendar units and spelled out units,
+// optionally separated by spaces or commas: "1d", "2w", "1 month", "1y2mo3d4h", "-1 year, 2 days".
+// A leading sign applies to the whole duration. Only clock units (hours and below) accept a fractional value.
+// Units are case-insensitive, except for the upper-case "M" which is rejected as ambiguous.
+// ISO 8601 durations (e.g., "P1DT2H", "PT90M") are also accepted, see parseISO8601Duration.
+func parseDuration(s string) (calendarDuration, error) {
+	var d calendarDuration
+
+	// Go durations are accepted as is.
+	if clock, err := time.ParseDuration(s); err == nil {
+		d.clock = clock
+		return d, nil
+	}
+
+	input := strings.TrimSpace(s)
+
+	if strings.HasPrefix(strings.ToUpper(strings.TrimLeft(input, "+-")), "P") {
+		return parseISO8601Duration(input)
+	}
+
+	sign := 1
+	if input != "" && (input[0] == '-' || input[0] == '+') {
+		if input[0] == '-' {
+			sign = -1
+		}
+		input = strings.TrimSpace(input[1:])
+	}
+
+	if input == "" {
+		return d, fmt.Errorf("empty duration")
+	}
+
+	for input != "" {
+		// Leading number, with an optional fraction.
+		i := 0
+		for i < len(input) && (input[i] >= '0' && input[i] <= '9' || input[i] == '.') {
+			i++
+		}
+		number := input[:i]
+		if number == "" {
+			return d, fmt.Errorf("expected a number at %q", input)
+		}
+		input = strings.TrimLeft(input[i:], " ")
+
+		// Unit name.
+		j := strings.IndexFunc(input, func(r rune) bool { return !unicode.IsLetter(r) })
+		if j < 0 {
+			j = len(input)
+		}
+		name := input[:j]
+		input = strings.TrimLeft(input[j:], " ,")
+
+		// A bare "M" means months in ISO 8601 but minutes in Go durations.
+		if name == "M" {
+			return d, fmt.Errorf("ambiguous unit %q, use \"mo\" for months or \"m\" for minutes", name)
+		}
+
+		unit, ok := durationUnits[strings.ToLower(name)]
+		if !ok {
+			return d, fmt.Errorf("unknown unit %q", name)
+		}
+
+		if unit.calendar != nil {
+			n, err := parseInteger(number)
+			if err != nil {
+				return d, fmt.Errorf("invalid value %q for unit %q: %w", number, name, err)
+			}
+			unit.calendar(&d, sign*n)
+			continue
+		}
+
+		v, err := parseFixed(number, unit.clock)
+		if err != nil {
+			return d, fmt.Errorf("invalid value %q for unit %q: %w", number, name, err)
+		}
+		d.clock += time.Duration(sign) * v
+	}
+
+	return d, nil
+}
+
+// parseInteger parses a non-negative integer.
+func parseInteger(s string) (n int, err error) {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return 0, fmt.Errorf("not an integer")
+		}
+		n = n*10 + int(c-'0')
+		if n > 1<<31 {
+			return 0, fmt.Errorf("value out of range")
+		}
+	}
+
+	return n, nil
+}
+
+// parseFixed parses a non-negative decimal number of units, without floating point rounding.
+func parseFixed(s string, unit time.Duration) (time.Duration, error) {
+	integer, fraction, _ := strings.Cut(s, ".")
+	if integer == "" && fraction == "" {
+		return 0, fmt.Errorf("not a number")
+	}
+
+	n, err := parseInteger(integer)
+	if err != nil {
+		return 0, err
+	}
+	if time.Duration(n) > (1<<63-1)/unit {
+		return 0, fmt.Errorf("value out of range")
+	}
+	v := time.Duration(n) * unit
+
+	// Add the fractional part, digit by digit.
+	scale := unit
+	for _, c := range fraction {
+		if c < '0' || c > '9' {
+			return 0, fmt.Errorf("not a number")
+		}
+		scale /= 10
+		v += time.Duration(c-'0') * scale
+	}
+
+	return v, nil
+}
+
+// parseISO8601Duration parses an ISO 8601 duration of the form [±]PnYnMnWnDTnHnMnS (e.g., "P1DT2H", "PT90M", "-P1W").
+//
+// Weeks may be combined with other components. The smallest component may have a fractional value,
+// using a dot or a comma as decimal separator, except for years and months which have no fixed length.
+// Fractional weeks and days are converted to whole days plus elapsed time (e.g., "P1.5D" is 1 day and 12 hours).
+func parseISO8601Duration(s string) (calendarDuration, error) {
+	var d calendarDuration
+
+	input := strings.ToUpper(strings.TrimSpace(s))
+
+	sign := 1
+	if input != "" && (input[0] == '-' || input[0] == '+') {
+		if input[0] == '-' {
+			sign = -1
+		}
+		input = input[1:]
+	}
+
+	if !strings.HasPrefix(input, "P") {
+		return d, fmt.Errorf("missing P designator")
+	}
+	input = input[1:]
+
+	// Designators in their expected order, for the date and the time part.
+	order := "YMWD"
+	timePart := false
+	components := 0
+	fractional := false
+
+	for input != "" {
+		if input[0] == 'T' {
+			if timePart {
+				return d, fmt.Errorf("duplicate T designator")
+			}
+			timePart = true
+			order = "HMS"
+			input = input[1:]
+			if input == "" {
+				return d, fmt.Errorf("missing time component after T")
+			}
+			continue
+		}
+
+		if fractional {
+			return d, fmt.Errorf("only the smallest component may have a fractional value")
+		}
+
+		// Number, with an optional fraction.
+		i := 0
+		for i < len(input) && (input[i] >= '0' && input[i] <= '9' || input[i] == '.' || input[i] == ',') {
+			i++
+		}
+		if i == 0 || i == len(input) {
+			return d, fmt.Errorf("expected a number followed by a designator at %q", input)
+		}
+		number := strings.Replace(input[:i], ",", ".", 1)
+		designator := input[i]
+		input = input[i+1:]
+		fractional = strings.Contains(number, ".")
+
+		// Enforce the order of the designators.
+		position := strings.IndexByte(order, designator)
+		if position < 0 {
+			return d, fmt.Errorf("unexpected designator %q", designator)
+		}
+		order = order[position+1:]
+		components++
+
+		switch {
+		case !timePart && (designator == 'Y' || designator == 'M'):
+			n, err := parseInteger(number)
+			if err != nil {
+				return d, fmt.Errorf("invalid value %q for designator %q: %w", number, designator, err)
+			}
+			if designator == 'Y' {
+				d.years = sign * n
+			} else {
+				d.months = sign * n
+			}
+		case !timePart:
+			unit := 24 * time.Hour
+			if designator == 'W' {
+				unit *= 7
+			}
+			v, err := parseFixed(number, unit)
+			if err != nil {
+				return d, fmt.Errorf("invalid value %q for designator %q: %w", number, designator, err)
+			}
+			d.days += sign * int(v/(24*time.Hour))
+			d.clock += time.Duration(sign) * (v % (24 * time.Hour))
+		default:
+			unit := map[byte]time.Duration{'H': time.Hour, 'M': time.Minute, 'S': time.Second}[designator]
+			v, err := parseFixed(number, unit)
+			if err != nil {
+				return d, fmt.Errorf("invalid value %q for designator %q: %w", number, designator, err)
+			}
+			d.clock += time.Duration(sign) * v
+		}
+	}
+
+	if components == 0 {
+		return d, fmt.Errorf("no duration component")
+	}
+
+	return d, nil
+}
+
+// iso8601 formats the duration as an ISO 8601 duration (e.g., "P1Y2M3DT4H5M6.5S").
+// The clock part is split into hours, minutes and seconds. A negative duration is prefixed with a minus sign,
+// components of mixed signs are written with their own sign.
+func (d calendarDuration) iso8601() string {
+	prefix := ""
+	if d.years <= 0 && d.months <= 0 && d.days <= 0 && d.clock <= 0 && d != (calendarDuration{}) {
+		prefix = "-"
+		d = calendarDuration{years: -d.years, months: -d.months, days: -d.days, clock: -d.clock}
+	}
+
+	var b strings.Builder
+	b.WriteString(prefix + "P")
+	for _, c := range []struct {
+		value      int
+		designator string
+	}{{d.years, "Y"}, {d.months, "M"}, {d.days, "D"}} {
+		if c.value != 0 {
+			fmt.Fprintf(&b, "%d%s", c.value, c.designator)
+		}
+	}
+
+	if d.clock != 0 {
+		b.WriteString("T")
+		hours := d.clock / time.Hour
+		minutes := (d.clock % time.Hour) / time.Minute
+		seconds := d.clock % time.Minute
+		if hours != 0 {
+			fmt.Fprintf(&b, "%dH", hours)
+		}
+		if minutes != 0 {
+			fmt.Fprintf(&b, "%dM", minutes)
+		}
+		if seconds != 0 {
+			fmt.Fprintf(&b, "%sS", strconv.FormatFloat(seconds.Seconds(), 'f', -1, 64))
+		}
+	} else if b.Len() == len(prefix)+1 {
+		// A zero duration needs at least one component.
+		b.WriteString("T0S")
+	}
+
+	return b.String()
+}
@@ -0,0 +1,186 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+)
+
+// TestParseDuration tests the parseDuration function.
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		name             string
+		duration         string
+		expectedDuration calendarDuration
+	}{
+		{
+			"go duration",
+			"1h2m3.5s",
+			calendarDuration{clock: time.Hour + 2*time.Minute + 3500*time.Millisecond},
+		},
+		{
+			"negative go duration",
+			"-1h",
+			calendarDuration{clock: -time.Hour},
+		},
+		{
+			"days",
+			"1d",
+			calendarDuration{days: 1},
+		},
+		{
+			"weeks",
+			"2w",
+			calendarDuration{days: 14},
+		},
+		{
+			"spelled out month",
+			"1 month",
+			calendarDuration{months: 1},
+		},
+		{
+			"combined units",
+			"1y2mo3d4h",
+			calendarDuration{years: 1, months: 2, days: 3, clock: 4 * time.Hour},
+		},
+		{
+			"negative spelled out units",
+			"-1 year, 2 days 1.5 hours",
+			calendarDuration{years: -1, days: -2, clock: -90 * time.Minute},
+		},
+		{
+			"upper case",
+			"3 Days",
+			calendarDuration{days: 3},
+		},
+		{
+			"upper case months",
+			"1Y2MO",
+			calendarDuration{years: 1, months: 2},
+		},
+		{
+			"ISO 8601 days and hours",
+			"P1DT2H",
+			calendarDuration{days: 1, clock: 2 * time.Hour},
+		},
+		{
+			"ISO 8601 minutes",
+			"PT90M",
+			calendarDuration{clock: 90 * time.Minute},
+		},
+		{
+			"ISO 8601 all components",
+			"P1Y2M3W4DT5H6M7.5S",
+			calendarDuration{years: 1, months: 2, days: 25, clock: 5*time.Hour + 6*time.Minute + 7500*time.Millisecond},
+		},
+		{
+			"ISO 8601 negative",
+			"-P1W",
+			calendarDuration{days: -7},
+		},
+		{
+			"ISO 8601 fractional days",
+			"P1.5D",
+			calendarDuration{days: 1, clock: 12 * time.Hour},
+		},
+		{
+			"ISO 8601 fractional weeks with comma",
+			"P0,5W",
+			calendarDuration{days: 3, clock: 12 * time.Hour},
+		},
+		{
+			"ISO 8601 lower case",
+			"pt1h",
+			calendarDuration{clock: time.Hour},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d, err := parseDuration(test.duration)
+			if err != nil {
+				t.Errorf("unexpected error %v", err)
+				return
+			}
+
+			if d != test.expectedDuration {
+				t.Errorf("expected duration %+v, got %+v", test.expectedDuration, d)
+			}
+		})
+	}
+}
+
+// TestParseDurationInvalid tests that parseDuration rejects invalid durations.
+func TestParseDurationInvalid(t *testing.T) {
+	tests := []string{
+		"",
+		"-",
+		"1",
+		"d",
+		"1.5d",
+		"1 fortnight",
+		"1M",
+		"1y2M",
+		"1h-2m",
+		"P",
+		"PT",
+		"P1H",
+		"PT1D",
+		"P1D2Y",
+		"P1.5Y",
+		"P1.5DT1H",
+		"P1DT",
+	}
+
+	for _, test := range tests {
+		t.Run(test, func(t *testing.T) {
+			_, err := parseDuration(test)
+			if err == nil {
+				t.Errorf("expected error for %q", test)
+			}
+		})
+	}
+}
+
+// TestCalendarDurationISO8601 tests the iso8601 method of calendarDuration.
+func TestCalendarDurationISO8601(t *testing.T) {
+	tests := []struct {
+		name           string
+		duration       calendarDuration
+		expectedOutput string
+	}{
+		{
+			"zero",
+			calendarDuration{},
+			"PT0S",
+		},
+		{
+			"all components",
+			calendarDuration{years: 1, months: 2, days: 3, clock: 4*time.Hour + 5*time.Minute + 6500*time.Millisecond},
+			"P1Y2M3DT4H5M6.5S",
+		},
+		{
+			"clock only",
+			calendarDuration{clock: 90 * time.Minute},
+			"PT1H30M",
+		},
+		{
+			"negative",
+			calendarDuration{days: -1, clock: -2 * time.Hour},
+			"-P1DT2H",
+		},
+		{
+			"mixed signs",
+			calendarDuration{months: 1, days: -1},
+			"P1M-1D",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			output := test.duration.iso8601()
+			if output != test.expectedOutput {
+				t.Errorf("expected output %q, got %q", test.expectedOutput, output)
+			}
+		})
+	}
+}
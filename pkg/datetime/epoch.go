@@ -0,0 +1,70 @@
+package datetime
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// epochUnits maps the Unix timestamp format names to their precision.
+var epochUnits = map[string]time.Duration{
+	"Unix":      time.Second,
+	"UnixMilli": time.Millisecond,
+	"UnixMicro": time.Microsecond,
+	"UnixNano":  time.Nanosecond,
+}
+
+// getEpochFormats returns the sorted names of the Unix timestamp formats.
+func getEpochFormats() []string { return slices.Sorted(maps.Keys(epochUnits)) }
+
+// formatEpoch returns the Unix timestamp of t at the given precision.
+func formatEpoch(t time.Time, unit time.Duration) string {
+	var n int64
+	switch unit {
+	case time.Second:
+		n = t.Unix()
+	case time.Millisecond:
+		n = t.UnixMilli()
+	case time.Microsecond:
+		n = t.UnixMicro()
+	default:
+		n = t.UnixNano()
+	}
+
+	return strconv.FormatInt(n, 10)
+}
+
+// parseEpoch parses a Unix timestamp at the given precision (e.g., "1751968800" seconds, "1751968800123" milliseconds).
+// The timestamp may be negative and have a decimal fraction of its unit (e.g., "1751968800.5" seconds).
+func parseEpoch(value string, unit time.Duration) (time.Time, error) {
+	integer, fraction, hasFraction := strings.Cut(strings.TrimSpace(value), ".")
+	n, err := strconv.ParseInt(integer, 10, 64)
+	if err != nil || (hasFraction && (fraction == "" || strings.Trim(fraction, "0123456789") != "")) {
+		return time.Time{}, fmt.Errorf("invalid Unix timestamp: %s", value)
+	}
+
+	// The fraction of the unit, in nanoseconds of the unit.
+	fraction = (fraction + "000000000")[:9]
+	nanos, _ := strconv.ParseInt(fraction, 10, 64)
+	offset := time.Duration(nanos * int64(unit) / int64(time.Second))
+	if strings.HasPrefix(integer, "-") {
+		offset = -offset
+	}
+
+	var t time.Time
+	switch unit {
+	case time.Second:
+		t = time.Unix(n, 0)
+	case time.Millisecond:
+		t = time.UnixMilli(n)
+	case time.Microsecond:
+		t = time.UnixMicro(n)
+	default:
+		t = time.Unix(0, n)
+	}
+
+	return t.Add(offset).UTC(), nil
+}
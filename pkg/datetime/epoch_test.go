@@ -0,0 +1,100 @@
+package datetime
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestFormatEpoch tests the Unix timestamp output formats.
+func TestFormatEpoch(t *testing.T) {
+	dt := fromTime(time.Date(2025, time.July, 8, 10, 0, 0, 123456789, time.UTC))
+
+	tests := []struct {
+		format         Format
+		expectedOutput string
+	}{
+		{Format{Layout: "Unix"}, "1751968800"},
+		{Format{Layout: "UnixMilli"}, "1751968800123"},
+		{Format{Layout: "UnixMicro"}, "1751968800123456"},
+		{Format{Layout: "UnixNano"}, "1751968800123456789"},
+		// Unix timestamps are recognized in every style.
+		{Format{Layout: "UnixMilli", Style: "strftime"}, "1751968800123"},
+		{Format{Layout: "Unix", Locale: "fr"}, "1751968800"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.format.Layout, func(t *testing.T) {
+			output, err := dt.format(test.format, "Asia/Tokyo")
+			if err != nil {
+				t.Errorf("unexpected error %v", err)
+				return
+			}
+
+			if output != test.expectedOutput {
+				t.Errorf("expected output %q, got %q", test.expectedOutput, output)
+			}
+		})
+	}
+}
+
+// TestConvertTimeInputFormat tests ConvertTime with an explicit input format.
+func TestConvertTimeInputFormat(t *testing.T) {
+	tests := []struct {
+		inputTime      string
+		inputFormat    string
+		outputFormat   string
+		expectedOutput string
+	}{
+		{"1751968800", "Unix", "", "2025-07-08T12:00:00+02:00"},
+		{"1751968800123", "UnixMilli", "RFC3339nano", "2025-07-08T12:00:00.123+02:00"},
+		{"1751968800123456", "UnixMicro", "RFC3339nano", "2025-07-08T12:00:00.123456+02:00"},
+		{"1751968800123456789", "UnixNano", "RFC3339nano", "2025-07-08T12:00:00.123456789+02:00"},
+		{"1751968800.5", "Unix", "RFC3339nano", "2025-07-08T12:00:00.5+02:00"},
+		{"-86400", "Unix", "DateOnly", "1969-12-31"},
+		// A short timestamp is not mistaken for another precision.
+		{"1751968800", "UnixMilli", "RFC3339nano", "1970-01-21T07:39:28.8+01:00"},
+		{"1751968800123", "UnixMilli", "UnixNano", "1751968800123000000"},
+		// Without an input format, the time is guessed.
+		{"2025-07-08 10:00", "", "RFC3339", "2025-07-08T12:00:00+02:00"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.inputFormat+" "+test.inputTime, func(t *testing.T) {
+			output, err := ConvertTime(test.inputTime, "UTC", "Europe/Paris", InputFormat{Layout: test.inputFormat}, Format{Layout: test.outputFormat})
+			if err != nil {
+				t.Errorf("unexpected error %v", err)
+				return
+			}
+
+			if output != test.expectedOutput {
+				t.Errorf("expected output %q, got %q", test.expectedOutput, output)
+			}
+		})
+	}
+}
+
+// TestConvertTimeInputFormatInvalid tests that invalid input formats and timestamps are rejected.
+func TestConvertTimeInputFormatInvalid(t *testing.T) {
+	tests := []struct {
+		inputTime    string
+		inputFormat  string
+		expectedCode string
+	}{
+		{"1751968800", "icu:yyyy", "invalid_input_format:"},
+		{"1751968800", "strftime:%V", "invalid_input_format:"},
+		{"2025-07-08", "Unix", "invalid_time:"},
+		{"1751968800.", "Unix", "invalid_time:"},
+		{"1751968800.5e3", "Unix", "invalid_time:"},
+		{"99999999999999999999", "UnixNano", "invalid_time:"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.inputFormat+" "+test.inputTime, func(t *testing.T) {
+			_, err := ConvertTime(test.inputTime, "", "", InputFormat{Layout: test.inputFormat}, Format{})
+			if err == nil || !strings.HasPrefix(err.Error(), test.expectedCode) {
+				t.Errorf("expected %s error, got %v", test.expectedCode, err)
+			}
+		})
+	}
+}
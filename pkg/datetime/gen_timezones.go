@@ -0,0 +1,56 @@
+//go:build ignore
+
+// This program generates timezones_list.go, the list of the timezone names available in
+// the timezone database embedded by the time/tzdata package, which is built from the
+// $GOROOT/lib/time/zoneinfo.zip file of the Go toolchain.
+// The "Factory" placeholder zone, which has no real offset, is left out.
+//
+// Run it with go generate from the pkg/datetime directory.
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+)
+
+func main() {
+	archive, err := zip.OpenReader(filepath.Join(runtime.GOROOT(), "lib", "time", "zoneinfo.zip"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer archive.Close()
+
+	var names []string
+	for _, f := range archive.File {
+		if !f.FileInfo().IsDir() && f.Name != "Factory" {
+			names = append(names, f.Name)
+		}
+	}
+	slices.Sort(names)
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by gen_timezones.go from %s; DO NOT EDIT.\n\n", runtime.Version())
+	fmt.Fprintf(&b, "package datetime\n\n")
+	fmt.Fprintf(&b, "// timezoneNames is the sorted list of the timezone names available in the embedded timezone database.\n")
+	fmt.Fprintf(&b, "var timezoneNames = []string{\n")
+	for _, name := range names {
+		fmt.Fprintf(&b, "\t%q,\n", name)
+	}
+	fmt.Fprintf(&b, "}\n")
+
+	source, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile("timezones_list.go", source, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,89 @@
+package datetime
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/TheoBrigitte/mcp-time/pkg/holidays"
+)
+
+// HolidayCheck is the result of checking whether a day is a holiday.
+type HolidayCheck struct {
+	// Date is the checked date, in the YYYY-MM-DD format.
+	Date string `json:"date"`
+	// Country is the country code of the holiday calendar.
+	Country string `json:"country"`
+	// IsHoliday is set when the date is a holiday, or the observed day of a holiday.
+	IsHoliday bool `json:"is_holiday"`
+	// Holidays lists the holidays falling on the date.
+	Holidays []holidays.Holiday `json:"holidays"`
+}
+
+// GetHolidayCountries returns the country codes of the available holiday calendars.
+func GetHolidayCountries() []string { return holidays.Countries() }
+
+// lookupHolidays returns the holiday calendar of the given country code.
+func lookupHolidays(country string) (*holidays.Calendar, error) {
+	c, err := holidays.Lookup(country)
+	if err != nil {
+		return nil, fmt.Errorf("invalid_country: Unknown holiday country code: %s (available: %s)", country, strings.Join(holidays.Countries(), ", "))
+	}
+
+	return c, nil
+}
+
+// IsHoliday checks whether the day of a given time string is a public holiday in the given country.
+// The input time is interpreted, and its day determined, in the given timezone.
+func IsHoliday(inputTime, country, timezone string, inputFormat InputFormat) (*HolidayCheck, error) {
+	calendar, err := lookupHolidays(country)
+	if err != nil {
+		return nil, err
+	}
+
+	dt, err := fromStringWithTimezone(inputTime, inputFormat, timezone, "time")
+	if err != nil {
+		return nil, err
+	}
+
+	// The day is determined in the requested timezone.
+	if timezone != "" {
+		location, err := ResolveTimezone(timezone)
+		if err != nil {
+			return nil, err
+		}
+		dt.time = dt.time.In(location)
+	}
+
+	h := calendar.On(dt.time)
+	if h == nil {
+		h = []holidays.Holiday{}
+	}
+
+	return &HolidayCheck{
+		Date:      holidays.DateOf(dt.time).String(),
+		Country:   calendar.Country,
+		IsHoliday: len(h) > 0,
+		Holidays:  h,
+	}, nil
+}
+
+// ListHolidays returns the public holidays of the given country for a year, including observed days.
+// If year is zero, the current year in UTC is used.
+func ListHolidays(country string, year int) ([]holidays.Holiday, error) {
+	calendar, err := lookupHolidays(country)
+	if err != nil {
+		return nil, err
+	}
+
+	if year == 0 {
+		year = time.Now().UTC().Year()
+	}
+
+	h := calendar.Holidays(year)
+	if h == nil {
+		h = []holidays.Holiday{}
+	}
+
+	return h, nil
+}
@@ -0,0 +1,218 @@
+package datetime
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+)
+
+// humanizeUnits lists the units of Humanize, from the smallest to the largest.
+var humanizeUnits = []string{"second", "minute", "hour", "day", "month", "year"}
+
+// GetHumanizeUnits returns the granularities accepted by Humanize, from the smallest to the largest.
+func GetHumanizeUnits() []string { return humanizeUnits }
+
+// Indexes of the units in humanizeUnits.
+const (
+	unitSecond = iota
+	unitMinute
+	unitHour
+	unitDay
+	unitMonth
+	unitYear
+)
+
+// clockUnits are the lengths of the units measured as elapsed time.
+var clockUnits = map[int]time.Duration{unitSecond: time.Second, unitMinute: time.Minute, unitHour: time.Hour}
+
+// HumanizeThresholds defines from which amount a difference is expressed in the next larger unit.
+// Zero fields use the default thresholds (45 seconds, 45 minutes, 22 hours, 26 days and 11 months).
+type HumanizeThresholds struct {
+	// Seconds is the number of seconds from which a difference is expressed in minutes.
+	Seconds int `json:"seconds,omitempty"`
+	// Minutes is the number of minutes from which a difference is expressed in hours.
+	Minutes int `json:"minutes,omitempty"`
+	// Hours is the number of hours from which a difference is expressed in days.
+	Hours int `json:"hours,omitempty"`
+	// Days is the number of days from which a difference is expressed in months.
+	Days int `json:"days,omitempty"`
+	// Months is the number of months from which a difference is expressed in years.
+	Months int `json:"months,omitempty"`
+}
+
+// defaultHumanizeThresholds are the thresholds used for zero fields of HumanizeThresholds.
+var defaultHumanizeThresholds = HumanizeThresholds{Seconds: 45, Minutes: 45, Hours: 22, Days: 26, Months: 11}
+
+// validate checks that no threshold is negative.
+func (h HumanizeThresholds) validate() error {
+	for _, threshold := range []int{h.Seconds, h.Minutes, h.Hours, h.Days, h.Months} {
+		if threshold < 0 {
+			return fmt.Errorf("invalid_thresholds: Thresholds must not be negative, got %d", threshold)
+		}
+	}
+
+	return nil
+}
+
+// largestUnit returns the unit in which a difference is expressed, given the thresholds and its calendar breakdown.
+func (h HumanizeThresholds) largestUnit(d time.Duration, calendar CalendarDifference) int {
+	thresholds := []int{
+		cmp.Or(h.Seconds, defaultHumanizeThresholds.Seconds),
+		cmp.Or(h.Minutes, defaultHumanizeThresholds.Minutes),
+		cmp.Or(h.Hours, defaultHumanizeThresholds.Hours),
+		cmp.Or(h.Days, defaultHumanizeThresholds.Days),
+		cmp.Or(h.Months, defaultHumanizeThresholds.Months),
+	}
+
+	// Amounts of each unit, rounded to the nearest for elapsed time.
+	amounts := []int{
+		int(d.Round(time.Second) / time.Second),
+		int(d.Round(time.Minute) / time.Minute),
+		int(d.Round(time.Hour) / time.Hour),
+		int(d.Round(24*time.Hour) / (24 * time.Hour)),
+		calendar.Years*12 + calendar.Months,
+	}
+
+	unit := unitSecond
+	for unit < unitYear && amounts[unit] >= thresholds[unit] {
+		unit++
+	}
+
+	return unit
+}
+
+// roundDifference returns end rounded to the nearest whole unit from start, halfway rounding up.
+// Elapsed time units are rounded as durations, and calendar units on the wall clock of start.
+func roundDifference(start, end time.Time, unit int) time.Time {
+	if length, ok := clockUnits[unit]; ok {
+		return start.Add(end.Sub(start).Round(length))
+	}
+
+	c := calendarDifference(start, end)
+	var base, next time.Time
+	switch unit {
+	case unitDay:
+		base = addDate(start, c.Years, c.Months, c.Days)
+		next = addDate(start, c.Years, c.Months, c.Days+1)
+	case unitMonth:
+		base = addDate(start, c.Years, c.Months, 0)
+		next = addDate(start, c.Years, c.Months+1, 0)
+	default:
+		base = addDate(start, c.Years, 0, 0)
+		next = addDate(start, c.Years+1, 0, 0)
+	}
+
+	if end.Sub(base) >= next.Sub(end) {
+		return next
+	}
+
+	return base
+}
+
+// unitAmounts breaks down the difference end - start into the amounts of each unit, the largest unit absorbing the
+// larger ones (e.g., 2 days and 3 hours are 51 hours when the largest unit is hour). Elapsed time units are counted as
+// elapsed time, and calendar units on the wall clock of start.
+func unitAmounts(start, end time.Time, largest int) []int {
+	amounts := make([]int, len(humanizeUnits))
+
+	if largest <= unitHour {
+		rest := end.Sub(start)
+		for unit := largest; unit >= unitSecond; unit-- {
+			amounts[unit] = int(rest / clockUnits[unit])
+			rest -= time.Duration(amounts[unit]) * clockUnits[unit]
+		}
+		return amounts
+	}
+
+	c := calendarDifference(start, end)
+	amounts[unitSecond], amounts[unitMinute], amounts[unitHour] = int(math.Round(c.Seconds)), c.Minutes, c.Hours
+	amounts[unitDay], amounts[unitMonth], amounts[unitYear] = c.Days, c.Months, c.Years
+
+	switch largest {
+	case unitMonth:
+		amounts[unitMonth] += 12 * c.Years
+		amounts[unitYear] = 0
+	case unitDay:
+		// Days between the start and the same wall clock after the whole months.
+		from := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+		to := addDate(from, c.Years, c.Months, 0)
+		amounts[unitDay] += int(to.Sub(from) / (24 * time.Hour))
+		amounts[unitMonth], amounts[unitYear] = 0, 0
+	}
+
+	return amounts
+}
+
+// Humanize renders a time relative to a reference time as human text (e.g., "3 hours ago", "in 2 days, 3 hours").
+// Input times without timezone are interpreted in the given timezone, whose wall clock is used to count calendar
+// units. The reference time defaults to the current time.
+//
+// The difference is expressed in the largest unit allowed by the thresholds, then in up to precision units, the
+// smallest being no smaller than granularity (e.g., "hour"). The smallest unit shown is rounded to the nearest,
+// units which are zero are omitted, and a difference rounding to zero is "just now".
+func Humanize(inputTime, referenceTime, timezone, granularity string, precision int, thresholds HumanizeThresholds, inputFormat InputFormat) (string, error) {
+	smallest := unitSecond
+	if granularity != "" {
+		smallest = slices.Index(humanizeUnits, strings.TrimSuffix(strings.ToLower(strings.TrimSpace(granularity)), "s"))
+		if smallest < 0 {
+			return "", fmt.Errorf("invalid_granularity: Invalid granularity: %s, expected one of %s", granularity, strings.Join(humanizeUnits, ", "))
+		}
+	}
+	if precision < 1 || precision > len(humanizeUnits) {
+		return "", fmt.Errorf("invalid_precision: Precision must be between 1 and %d", len(humanizeUnits))
+	}
+	if err := thresholds.validate(); err != nil {
+		return "", err
+	}
+
+	location, err := ResolveTimezone(timezone)
+	if err != nil {
+		return "", err
+	}
+
+	t, err := fromStringWithTimezone(inputTime, inputFormat, timezone, "time")
+	if err != nil {
+		return "", err
+	}
+	reference, err := fromStringWithTimezone(referenceTime, inputFormat, timezone, "reference_time")
+	if err != nil {
+		return "", err
+	}
+
+	start, end := reference.time.In(location), t.time.In(location)
+	future := end.After(start)
+	if !future {
+		start, end = end, start
+	}
+
+	largest := max(thresholds.largestUnit(end.Sub(start), calendarDifference(start, end)), smallest)
+	smallest = max(smallest, largest-precision+1)
+
+	amounts := unitAmounts(start, roundDifference(start, end, smallest), largest)
+
+	var parts []string
+	for unit := unitYear; unit >= smallest && len(parts) < precision; unit-- {
+		if amounts[unit] == 0 {
+			continue
+		}
+		name := humanizeUnits[unit]
+		if amounts[unit] != 1 {
+			name += "s"
+		}
+		parts = append(parts, fmt.Sprintf("%d %s", amounts[unit], name))
+	}
+
+	if len(parts) == 0 {
+		return "just now", nil
+	}
+
+	text := strings.Join(parts, ", ")
+	if future {
+		return "in " + text, nil
+	}
+
+	return text + " ago", nil
+}
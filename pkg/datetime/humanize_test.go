@@ -0,0 +1,88 @@
+package datetime
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestHumanize tests the Humanize function.
+func TestHumanize(t *testing.T) {
+	reference := "2025-07-09T12:00:00Z"
+
+	tests := []struct {
+		name        string
+		time        string
+		timezone    string
+		granularity string
+		precision   int
+		thresholds  HumanizeThresholds
+		expected    string
+	}{
+		{"same time", "2025-07-09T12:00:00Z", "", "", 1, HumanizeThresholds{}, "just now"},
+		{"seconds ago", "2025-07-09T11:59:30Z", "", "", 1, HumanizeThresholds{}, "30 seconds ago"},
+		{"one second", "2025-07-09T12:00:01Z", "", "", 1, HumanizeThresholds{}, "in 1 second"},
+		{"seconds threshold", "2025-07-09T11:59:10Z", "", "", 1, HumanizeThresholds{}, "1 minute ago"},
+		{"hours ago", "2025-07-09T09:00:00Z", "", "", 1, HumanizeThresholds{}, "3 hours ago"},
+		{"minutes threshold", "2025-07-09T12:50:00Z", "", "", 1, HumanizeThresholds{}, "in 1 hour"},
+		{"hours rounded", "2025-07-09T14:40:00Z", "", "", 1, HumanizeThresholds{}, "in 3 hours"},
+		{"hours threshold", "2025-07-10T11:00:00Z", "", "", 1, HumanizeThresholds{}, "in 1 day"},
+		{"custom threshold", "2025-07-10T11:00:00Z", "", "", 1, HumanizeThresholds{Hours: 36}, "in 23 hours"},
+		{"days", "2025-07-11T15:00:00Z", "", "", 1, HumanizeThresholds{}, "in 2 days"},
+		{"days and hours", "2025-07-11T15:00:00Z", "", "", 2, HumanizeThresholds{}, "in 2 days, 3 hours"},
+		{"zero units omitted", "2025-07-11T12:05:00Z", "", "", 3, HumanizeThresholds{}, "in 2 days, 5 minutes"},
+		{"precision rounds last unit", "2025-07-11T15:40:00Z", "", "", 2, HumanizeThresholds{}, "in 2 days, 4 hours"},
+		{"days threshold", "2025-08-05T12:00:00Z", "", "", 1, HumanizeThresholds{}, "in 1 month"},
+		{"months", "2025-03-01T00:00:00Z", "", "", 2, HumanizeThresholds{}, "4 months, 9 days ago"},
+		{"years", "2023-01-09T12:00:00Z", "", "", 2, HumanizeThresholds{}, "2 years, 6 months ago"},
+		{"granularity", "2025-07-09T11:59:30Z", "", "minute", 1, HumanizeThresholds{}, "1 minute ago"},
+		{"granularity rounding to zero", "2025-07-09T11:59:45Z", "", "minutes", 1, HumanizeThresholds{}, "just now"},
+		{"granularity larger than difference", "2025-07-09T15:00:00Z", "", "day", 2, HumanizeThresholds{}, "just now"},
+		{"hours threshold not reached", "2025-07-11T15:00:00Z", "", "", 1, HumanizeThresholds{Hours: 60}, "in 51 hours"},
+		{"days threshold not reached", "2025-09-09T12:00:00Z", "", "", 1, HumanizeThresholds{Days: 90}, "in 62 days"},
+		{"months threshold not reached", "2026-09-09T12:00:00Z", "", "", 2, HumanizeThresholds{Months: 36}, "in 14 months"},
+		{"rounding within the unit", "2025-07-09T12:59:40Z", "", "", 1, HumanizeThresholds{Minutes: 120}, "in 60 minutes"},
+		{"calendar days across dst", "2025-03-09T12:00:00-04:00", "America/New_York", "", 2, HumanizeThresholds{}, "in 1 day"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ref := reference
+			if test.timezone != "" {
+				ref = "2025-03-08T12:00:00-05:00"
+			}
+
+			output, err := Humanize(test.time, ref, test.timezone, test.granularity, test.precision, test.thresholds, InputFormat{})
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+
+			if output != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, output)
+			}
+		})
+	}
+}
+
+// TestHumanizeInvalid tests that Humanize rejects invalid arguments.
+func TestHumanizeInvalid(t *testing.T) {
+	tests := []struct {
+		granularity    string
+		precision      int
+		thresholds     HumanizeThresholds
+		expectedPrefix string
+	}{
+		{"fortnight", 1, HumanizeThresholds{}, "invalid_granularity:"},
+		{"", 0, HumanizeThresholds{}, "invalid_precision:"},
+		{"", 7, HumanizeThresholds{}, "invalid_precision:"},
+		{"", 1, HumanizeThresholds{Hours: -1}, "invalid_thresholds:"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expectedPrefix, func(t *testing.T) {
+			_, err := Humanize("", "", "", test.granularity, test.precision, test.thresholds, InputFormat{})
+			if err == nil || !strings.HasPrefix(err.Error(), test.expectedPrefix) {
+				t.Errorf("expected %s error, got %v", test.expectedPrefix, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,208 @@
+package datetime
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/araddon/dateparse"
+)
+
+// dateOrders lists the orders of the day, month and year in numeric dates, the default first.
+var dateOrders = []string{"MDY", "DMY", "YMD"}
+
+// GetDateOrders returns the supported orders of numeric dates, the default first.
+func GetDateOrders() []string { return dateOrders }
+
+// numericDate matches a numeric date at the start of an input time (e.g., "01/02/2024", "1.2.24", "24-02-01").
+var numericDate = regexp.MustCompile(`^(\d{1,4})([/.-])(\d{1,2})([/.-])(\d{1,4})(\D|$)`)
+
+// InputFormat describes how input times are read.
+type InputFormat struct {
+	// Layout is the format of the input times: a predefined layout name (e.g., "RFC3339"), a Unix timestamp format
+	// (e.g., "UnixMilli"), a Go layout (e.g., "02/01/2006 15:04") or a strftime format (e.g., "strftime:%d/%m/%Y").
+	// The format is guessed when empty.
+	Layout string
+	// Style is the style of a custom layout: "go" (default) or "strftime". The layout may also be prefixed by its
+	// style (e.g., "strftime:%d/%m/%Y"), which takes precedence. Other styles (e.g., "icu") are ignored, as they
+	// are shared with the output format.
+	Style string
+	// DateOrder is the order of the day, month and year used to read numeric dates when the format is guessed:
+	// "MDY" (e.g., "01/02/2024" is January 2), "DMY" (February 1) or "YMD". Dates starting with a four-digit year
+	// are always read as year, month and day.
+	// When empty, the order of numeric dates is guessed, month first when ambiguous.
+	DateOrder string
+}
+
+// dateOrder returns the normalized date order, or an error if it is unknown.
+func (f InputFormat) dateOrder() (string, error) {
+	order := strings.ToUpper(f.DateOrder)
+	if order != "" && !slices.Contains(dateOrders, order) {
+		return "", fmt.Errorf("invalid_date_order: Unknown date order: %s (available: %s)", f.DateOrder, strings.Join(dateOrders, ", "))
+	}
+
+	return order, nil
+}
+
+// layout returns the Go layout of the input format, or the precision of a Unix timestamp format.
+// The layout is empty when the format is guessed.
+func (f InputFormat) layout() (layout string, unit time.Duration, err error) {
+	if f.Layout == "" {
+		_, err = f.dateOrder()
+		return "", 0, err
+	}
+
+	if unit, ok := epochUnits[f.Layout]; ok {
+		return "", unit, nil
+	}
+	if layout, ok := layouts[f.Layout]; ok {
+		return layout, 0, nil
+	}
+
+	// A style prefix takes precedence over the style, which only applies to input formats for the styles
+	// which can read times: output only styles (e.g., "icu") are used by the output format alone.
+	style, layout, _ := Format{Layout: f.Layout}.style()
+	if layout == f.Layout && f.Style != "" {
+		s, _, err := Format{Style: f.Style}.style()
+		if err != nil {
+			return "", 0, err
+		}
+		if s == "strftime" {
+			style = s
+		}
+	}
+
+	switch style {
+	case defaultFormatStyle:
+		return layout, 0, nil
+	case "strftime":
+		if layout == "%s" {
+			return "", time.Second, nil
+		}
+		layout, err = strftimeLayout(layout)
+		return layout, 0, err
+	}
+
+	return "", 0, fmt.Errorf("invalid_input_format: Input formats of the %s style are not supported (available: %s, strftime)", style, defaultFormatStyle)
+}
+
+// fromStringWithFormat creates a new dateTime object from a string in the given input format.
+// When the format is empty, it is guessed (see fromStringWithLocation) and numeric dates are read in the date order.
+// The Unix timestamp formats (e.g., "UnixMilli") read an integer at that precision, whatever its number of digits.
+// If location is nil, it defaults to UTC.
+func fromStringWithFormat(inputTime string, inputFormat InputFormat, location *time.Location) (dt *dateTime, err error) {
+	layout, unit, err := inputFormat.layout()
+	if err != nil {
+		return nil, err
+	}
+	if inputTime == "" {
+		return fromStringWithLocation(inputTime, location)
+	}
+
+	if location == nil {
+		location = defaultLocation
+	}
+
+	switch {
+	case unit != 0:
+		t, err := parseEpoch(inputTime, unit)
+		if err != nil {
+			return nil, fmt.Errorf("invalid_time: Unable to parse input time as %s: %s", inputFormat.Layout, inputTime)
+		}
+
+		// The input time is not kept to infer the output format, which defaults to RFC 3339.
+		return fromTime(t), nil
+	case layout != "":
+		t, err := time.ParseInLocation(layout, inputTime, location)
+		if err != nil {
+			return nil, fmt.Errorf("invalid_time: Unable to parse input time as %s: %s", inputFormat.Layout, inputTime)
+		}
+
+		return &dateTime{time: t, inputTime: inputTime, layout: layout}, nil
+	}
+
+	order, _ := inputFormat.dateOrder()
+	if order == "" {
+		return fromStringWithLocation(inputTime, location)
+	}
+
+	t, layout, err := parseDateOrder(inputTime, order, location)
+	if err != nil {
+		return nil, fmt.Errorf("invalid_time: Unable to parse input time: %s", inputTime)
+	}
+
+	return &dateTime{time: t, inputTime: inputTime, layout: layout}, nil
+}
+
+// parseDateOrder parses a time whose numeric date is in the given order (e.g., "DMY" for "01/02/2024 10:30"),
+// and returns its layout in the order of the input.
+// The numeric date is rewritten as an ISO 8601 date before guessing the format of the time.
+// Two-digit years are read like Go layouts: 69 to 99 are in the 20th century and 00 to 68 in the 21st.
+func parseDateOrder(inputTime, order string, location *time.Location) (t time.Time, layout string, err error) {
+	m := numericDate.FindStringSubmatch(inputTime)
+	if m == nil || m[2] != m[4] || len(m[3]) > 2 {
+		return dateparseIn(inputTime, order, location)
+	}
+
+	// Map the fields of the input to the year, the month and the day.
+	fields := map[byte]string{}
+	if len(m[1]) == 4 {
+		order = "YMD"
+	}
+	for i, field := range []string{m[1], m[3], m[5]} {
+		fields[order[i]] = field
+	}
+	if len(fields['M']) > 2 || len(fields['D']) > 2 || (len(fields['Y']) != 2 && len(fields['Y']) != 4) {
+		return dateparseIn(inputTime, order, location)
+	}
+
+	year, _ := strconv.Atoi(fields['Y'])
+	if len(fields['Y']) == 2 {
+		year += 1900
+		if year < 1969 {
+			year += 100
+		}
+	}
+	month, _ := strconv.Atoi(fields['M'])
+	day, _ := strconv.Atoi(fields['D'])
+
+	date := fmt.Sprintf("%04d-%02d-%02d", year, month, day)
+	rest := inputTime[len(m[0])-len(m[6]):]
+	t, err = dateparse.ParseIn(date+rest, location)
+	if err != nil {
+		return time.Time{}, "", err
+	}
+
+	// Rebuild the layout of the date in the order of the input.
+	tokens := map[byte]string{'Y': "2006", 'M': "1", 'D': "2"}
+	if len(fields['Y']) == 2 {
+		tokens['Y'] = "06"
+	}
+	if len(fields['M']) == 2 {
+		tokens['M'] = "01"
+	}
+	if len(fields['D']) == 2 {
+		tokens['D'] = "02"
+	}
+	layout = tokens[order[0]] + m[2] + tokens[order[1]] + m[2] + tokens[order[2]]
+	if restLayout, err := dateparse.ParseFormat(date + rest); err == nil {
+		layout += restLayout[len(date):]
+	}
+
+	return t, layout, nil
+}
+
+// dateparseIn guesses the format of a time without a leading numeric date, preferring the day first for "DMY".
+func dateparseIn(inputTime, order string, location *time.Location) (time.Time, string, error) {
+	t, err := dateparse.ParseIn(inputTime, location, dateparse.PreferMonthFirst(order != "DMY"))
+	if err != nil {
+		return time.Time{}, "", err
+	}
+
+	layout, _ := dateparse.ParseFormat(inputTime, dateparse.PreferMonthFirst(order != "DMY"))
+
+	return t, layout, nil
+}
@@ -0,0 +1,110 @@
+package datetime
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestFromStringWithFormat tests reading input times in an explicit input format or date order.
+func TestFromStringWithFormat(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Fatalf("invalid location: %v", err)
+	}
+
+	tests := []struct {
+		inputTime    string
+		inputFormat  InputFormat
+		expectedTime time.Time
+		// expectedOutput is the output in the default format, which follows the input.
+		expectedOutput string
+	}{
+		// Named, Go and strftime layouts.
+		{"2025-07-08T10:30:00Z", InputFormat{Layout: "RFC3339"}, time.Date(2025, 7, 8, 10, 30, 0, 0, time.UTC), "2025-07-08T10:30:00Z"},
+		{"Tue Jul  8 10:30:00 2025", InputFormat{Layout: "ANSIC"}, time.Date(2025, 7, 8, 10, 30, 0, 0, paris), "Tue Jul  8 10:30:00 2025"},
+		{"01/02/2024 10:30", InputFormat{Layout: "02/01/2006 15:04"}, time.Date(2024, 2, 1, 10, 30, 0, 0, paris), "01/02/2024 10:30"},
+		{"01/02/2024 10:30", InputFormat{Layout: "go:01/02/2006 15:04"}, time.Date(2024, 1, 2, 10, 30, 0, 0, paris), "01/02/2024 10:30"},
+		{"01/02/2024 10:30", InputFormat{Layout: "strftime:%d/%m/%Y %H:%M"}, time.Date(2024, 2, 1, 10, 30, 0, 0, paris), "01/02/2024 10:30"},
+		{"01/02/2024 10:30", InputFormat{Layout: "%d/%m/%Y %H:%M", Style: "strftime"}, time.Date(2024, 2, 1, 10, 30, 0, 0, paris), "01/02/2024 10:30"},
+		// A prefix takes precedence over the style, and output only styles are ignored.
+		{"01/02/2024 10:30", InputFormat{Layout: "go:02/01/2006 15:04", Style: "strftime"}, time.Date(2024, 2, 1, 10, 30, 0, 0, paris), "01/02/2024 10:30"},
+		{"01/02/2024 10:30", InputFormat{Layout: "02/01/2006 15:04", Style: "icu"}, time.Date(2024, 2, 1, 10, 30, 0, 0, paris), "01/02/2024 10:30"},
+		{"01/02/2024 10:30", InputFormat{Layout: "strftime:%d/%m/%Y %H:%M", Style: "moment"}, time.Date(2024, 2, 1, 10, 30, 0, 0, paris), "01/02/2024 10:30"},
+		{"1.2.24 3:04:05.5 pm +0200", InputFormat{Layout: "strftime:%-d.%-m.%y %-I:%M:%S %P %z"}, time.Date(2024, 2, 1, 13, 4, 5, 5e8, time.UTC), "1.2.24 3:04:05 pm +0200"},
+		{"Saturday  1 February 2025, day 032", InputFormat{Layout: "strftime:%A %e %B %Y, day %j"}, time.Date(2025, 2, 1, 0, 0, 0, 0, paris), "Saturday  1 February 2025, day 032"},
+		{"2025-07-08T10:30:00", InputFormat{Layout: "strftime:%FT%T"}, time.Date(2025, 7, 8, 10, 30, 0, 0, paris), "2025-07-08T10:30:00"},
+		{"1751968800", InputFormat{Layout: "strftime:%s"}, time.Date(2025, 7, 8, 10, 0, 0, 0, time.UTC), "2025-07-08T10:00:00Z"},
+		// Date orders, when guessing the format.
+		{"01/02/2024", InputFormat{DateOrder: "DMY"}, time.Date(2024, 2, 1, 0, 0, 0, 0, paris), "01/02/2024"},
+		{"01/02/2024", InputFormat{DateOrder: "MDY"}, time.Date(2024, 1, 2, 0, 0, 0, 0, paris), "01/02/2024"},
+		{"13/02/2024 10:30", InputFormat{DateOrder: "dmy"}, time.Date(2024, 2, 13, 10, 30, 0, 0, paris), "13/02/2024 10:30"},
+		{"1.2.24 10:30:15", InputFormat{DateOrder: "DMY"}, time.Date(2024, 2, 1, 10, 30, 15, 0, paris), "1.2.24 10:30:15"},
+		{"01-02-99", InputFormat{DateOrder: "DMY"}, time.Date(1999, 2, 1, 0, 0, 0, 0, paris), "01-02-99"},
+		{"24/02/01", InputFormat{DateOrder: "YMD"}, time.Date(2024, 2, 1, 0, 0, 0, 0, paris), "24/02/01"},
+		{"2024/02/01", InputFormat{DateOrder: "DMY"}, time.Date(2024, 2, 1, 0, 0, 0, 0, paris), "2024/02/01"},
+		{"01/02/2024T10:30:00Z", InputFormat{DateOrder: "DMY"}, time.Date(2024, 2, 1, 10, 30, 0, 0, time.UTC), "01/02/2024T10:30:00Z"},
+		{"February 1, 2024", InputFormat{DateOrder: "DMY"}, time.Date(2024, 2, 1, 0, 0, 0, 0, paris), "February 1, 2024"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.inputTime, func(t *testing.T) {
+			dt, err := fromStringWithFormat(test.inputTime, test.inputFormat, paris)
+			if err != nil {
+				t.Errorf("unexpected error %v", err)
+				return
+			}
+
+			if !dt.time.Equal(test.expectedTime) {
+				t.Errorf("expected time %v, got %v", test.expectedTime, dt.time)
+			}
+
+			output, err := dt.format(Format{}, "")
+			if err != nil {
+				t.Errorf("unexpected error %v", err)
+				return
+			}
+
+			if output != test.expectedOutput {
+				t.Errorf("expected output %q, got %q", test.expectedOutput, output)
+			}
+		})
+	}
+}
+
+// TestFromStringWithFormatInvalid tests that invalid input formats, date orders and times are rejected.
+func TestFromStringWithFormatInvalid(t *testing.T) {
+	tests := []struct {
+		inputTime    string
+		inputFormat  InputFormat
+		expectedCode string
+	}{
+		{"2025-07-08", InputFormat{Layout: "02/01/2006"}, "invalid_time:"},
+		{"2025-07-08", InputFormat{Layout: "strftime:%d/%m/%Y"}, "invalid_time:"},
+		{"2025-07-08", InputFormat{Layout: "strftime:%G-W%V"}, "invalid_input_format:"},
+		{"2025-07-08", InputFormat{Layout: "strftime:Year 2025: %Y"}, "invalid_input_format:"},
+		{"2025-07-08", InputFormat{Layout: "strftime:%Y-%"}, "invalid_input_format:"},
+		{"2025-07-08", InputFormat{Layout: "moment:YYYY-MM-DD"}, "invalid_input_format:"},
+		{"2025-07-08", InputFormat{Layout: "YYYY", Style: "posix"}, "invalid_format_style:"},
+		{"01/02/2024", InputFormat{DateOrder: "DDMMYY"}, "invalid_date_order:"},
+		{"", InputFormat{DateOrder: "DDMMYY"}, "invalid_date_order:"},
+		{"01/13/2024", InputFormat{DateOrder: "DMY"}, "invalid_time:"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.inputTime+" "+test.inputFormat.Layout, func(t *testing.T) {
+			_, err := fromStringWithFormat(test.inputTime, test.inputFormat, nil)
+			if err == nil || !strings.HasPrefix(err.Error(), test.expectedCode) {
+				t.Errorf("expected %s error, got %v", test.expectedCode, err)
+			}
+
+			// The error of an invalid input format is reported as is by the tools with several times.
+			if test.expectedCode != "invalid_time:" {
+				_, err = fromStringWithTimezone(test.inputTime, test.inputFormat, "", "time")
+				if err == nil || !strings.HasPrefix(err.Error(), test.expectedCode) {
+					t.Errorf("expected %s error, got %v", test.expectedCode, err)
+				}
+			}
+		})
+	}
+}
@@ -1,12 +1,17 @@
 package datetime
 
 import (
+	"cmp"
 	"fmt"
 	"maps"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/araddon/dateparse"
+
+	"github.com/TheoBrigitte/mcp-time/pkg/holidays"
+	"github.com/TheoBrigitte/mcp-time/pkg/locale"
 )
 
 // defaultLayout is the default time format used when no other format is specified.
@@ -15,6 +20,9 @@ const defaultLayout = time.RFC3339
 // GetDefaultFormat returns the default format layout string.
 func GetDefaultFormat() string { return defaultLayout }
 
+// english is the locale of the pattern names used without a locale.
+var english, _ = locale.Lookup("en")
+
 // defaultLocation is the default timezone (UTC) used when no other timezone is specified.
 var defaultLocation = time.UTC
 
@@ -43,13 +51,70 @@ var layouts = map[string]string{
 	"TimeOnly":    time.TimeOnly,
 }
 
-// GetFormats returns a slice of all supported format names.
-func GetFormats() []string { return slices.Collect(maps.Keys(layouts)) }
+// GetFormats returns a slice of all supported format names, including the Unix timestamps and the locale patterns.
+func GetFormats() []string {
+	return slices.Concat(slices.Collect(maps.Keys(layouts)), getEpochFormats(), locale.Styles())
+}
+
+// GetInputFormats returns the predefined input format names, including the Unix timestamps.
+func GetInputFormats() []string {
+	return slices.Concat(slices.Collect(maps.Keys(layouts)), getEpochFormats())
+}
+
+// GetLocales returns the language tags of the supported locales.
+func GetLocales() []string { return locale.Tags() }
+
+// Format describes how times are written.
+type Format struct {
+	// Layout is a predefined layout name (e.g., "RFC3339"), a locale pattern name (e.g., "DateLong") or a Go layout.
+	Layout string
+	// Locale is the language tag used for month names, weekday names and patterns (e.g., "fr", "de-AT").
+	// Times are written in English when empty.
+	Locale string
+	// Style is the style of a custom layout: "go" (default), "strftime" (e.g., "%Y-%m-%d"),
+	// "icu" for ICU and Java patterns (e.g., "yyyy-MM-dd'T'HH:mm:ssXXX") or "moment" for Moment.js formats (e.g., "YYYY-MM-DD").
+	// When empty, the layout may be prefixed by its style instead (e.g., "strftime:%Y-%m-%d").
+	// Predefined layout and pattern names are recognized in every style.
+	Style string
+}
+
+// defaultFormatStyle is the style of custom layouts when none is specified.
+const defaultFormatStyle = "go"
+
+// formatStyles maps the styles of custom layouts, other than Go layouts, to their formatter.
+var formatStyles = map[string]func(t time.Time, layout string, l *locale.Locale) (string, error){
+	"strftime": formatStrftime,
+	"icu":      patternFormatter(compileICU),
+	"moment":   patternFormatter(compileMoment),
+}
+
+// GetFormatStyles returns the supported styles of custom layouts, the default first.
+func GetFormatStyles() []string {
+	return append([]string{defaultFormatStyle}, slices.Sorted(maps.Keys(formatStyles))...)
+}
+
+// style returns the style of the format and its layout, without its style prefix.
+func (f Format) style() (style, layout string, err error) {
+	style, layout = f.Style, f.Layout
+	if style == "" {
+		if prefix, rest, ok := strings.Cut(layout, ":"); ok && (prefix == defaultFormatStyle || formatStyles[prefix] != nil) {
+			style, layout = prefix, rest
+		}
+	}
+
+	style = cmp.Or(strings.ToLower(style), defaultFormatStyle)
+	if style != defaultFormatStyle && formatStyles[style] == nil {
+		return "", "", fmt.Errorf("invalid_format_style: Unknown format style: %s (available: %s)", f.Style, strings.Join(GetFormatStyles(), ", "))
+	}
+
+	return style, layout, nil
+}
 
 // dateTime represents a time value along with its original string representation.
 type dateTime struct {
 	time      time.Time
 	inputTime string // The original string used to create the time.
+	layout    string // The layout of the input time, when known.
 }
 
 // fromTime creates a new dateTime object from a time.Time object.
@@ -57,9 +122,10 @@ func fromTime(t time.Time) *dateTime {
 	return &dateTime{time: t}
 }
 
-// fromString creates a new dateTime object from a string, assuming UTC if no timezone is specified.
-func fromString(inputTime string) (dt *dateTime, err error) {
-	return fromStringWithLocation(inputTime, nil)
+// fromString creates a new dateTime object from a string in the given input format,
+// assuming UTC if no timezone is specified.
+func fromString(inputTime string, inputFormat InputFormat) (dt *dateTime, err error) {
+	return fromStringWithFormat(inputTime, inputFormat, nil)
 }
 
 // fromStringWithLocation creates a new dateTime object from a string and a specific location.
@@ -90,29 +156,85 @@ func fromStringWithLocation(inputTime string, location *time.Location) (dt *date
 	return dt, nil
 }
 
-// format formats the dateTime object into a string using the specified layout and timezone.
-// If format is empty, it attempts to infer the format from the original input string.
+// fromStringWithTimezone creates a new dateTime object from a string in the given input format, interpreted in
+// the given timezone when the string does not carry its own. The name identifies the argument in error messages.
+func fromStringWithTimezone(inputTime string, inputFormat InputFormat, timezone, name string) (dt *dateTime, err error) {
+	var location = defaultLocation
+	if timezone != "" {
+		// Resolve the input timezone location.
+		location, err = ResolveTimezone(timezone)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Report an invalid input format rather than the input time.
+	if _, _, err := inputFormat.layout(); err != nil {
+		return nil, err
+	}
+
+	dt, err = fromStringWithFormat(inputTime, inputFormat, location)
+	if err != nil {
+		return nil, fmt.Errorf("invalid_time: invalid format for %s: %q", name, inputTime)
+	}
+
+	return dt, nil
+}
+
+// format formats the dateTime object into a string using the specified format and timezone.
+// If the layout is empty, it uses the DateTimeMedium pattern of the locale, or the layout of the input time,
+// or attempts to infer the layout from the original input string.
 // If timezone is specified, it converts the time to that timezone.
-func (dt dateTime) format(format, timezone string) (output string, err error) {
+func (dt dateTime) format(format Format, timezone string) (output string, err error) {
 	if timezone != "" {
 		// Apply the specified timezone to the time.
-		location, err := time.LoadLocation(timezone)
+		location, err := ResolveTimezone(timezone)
 		if err != nil {
-			return "", fmt.Errorf("invalid_timezone: Invalid IANA timezone name: %s", timezone)
+			return "", err
 		}
 		dt.time = dt.time.In(location)
 	}
 
+	var l *locale.Locale
+	if format.Locale != "" {
+		var ok bool
+		l, ok = locale.Lookup(format.Locale)
+		if !ok {
+			return "", fmt.Errorf("invalid_locale: Unknown locale: %s (available: %s)", format.Locale, strings.Join(locale.Tags(), ", "))
+		}
+	}
+
+	style, layout, err := format.style()
+	if err != nil {
+		return "", err
+	}
+
 	// If a specific format is requested, use it. Otherwise, try to infer it.
-	var layout string
-	if format != "" {
+	if layout != "" {
 		var ok bool
-		// Check if the format is a predefined layout name.
-		layout, ok = layouts[format]
+		// Check if the format is a Unix timestamp, a predefined layout name, or a locale pattern name.
+		name := layout
+		if unit, ok := epochUnits[name]; ok {
+			return formatEpoch(dt.time, unit), nil
+		}
+		layout, ok = layouts[name]
+		if !ok {
+			layout, ok = cmp.Or(l, english).Layout(name)
+		}
+		if !ok && style != defaultFormatStyle {
+			// Custom layouts of other styles have their own formatter.
+			return formatStyles[style](dt.time, name, l)
+		}
 		if !ok {
 			// If not a predefined name, use the format string directly.
-			layout = format
+			layout = name
 		}
+	} else if l != nil {
+		// Use the default pattern of the locale.
+		layout, _ = l.Layout("DateTimeMedium")
+	} else if dt.layout != "" {
+		// If no format is provided, use the layout the input time was read with.
+		layout = dt.layout
 	} else if dt.inputTime != "" {
 		// If no format is provided, try to infer the format from the input time string.
 		layout, err = dateparse.ParseFormat(dt.inputTime)
@@ -124,5 +246,77 @@ func (dt dateTime) format(format, timezone string) (output string, err error) {
 		layout = defaultLayout
 	}
 
+	if l != nil {
+		return l.Format(dt.time, layout), nil
+	}
+
 	return dt.time.Format(layout), nil
 }
+
+// addDate adds the given number of years, months and days to t, keeping its wall clock.
+// Unlike time.AddDate, adding years or months never overflows into the following month:
+// when the target month is shorter, the day is clamped to its last day
+// (e.g. January 31 + 1 month is February 28, or February 29 on leap years).
+func addDate(t time.Time, years, months, days int) time.Time {
+	if years != 0 || months != 0 {
+		year, month, day := t.Date()
+		hour, minute, second := t.Clock()
+
+		// Normalize the target month, then clamp the day to its length.
+		first := time.Date(year+years, month+time.Month(months), 1, 0, 0, 0, 0, time.UTC)
+		day = min(day, holidays.DaysIn(first.Year(), first.Month()))
+
+		t = time.Date(first.Year(), first.Month(), day, hour, minute, second, t.Nanosecond(), t.Location())
+	}
+
+	return t.AddDate(0, 0, days)
+}
+
+// wallClock returns the wall clock of t as a time in UTC, which can be manipulated without daylight saving time effects.
+func wallClock(t time.Time) time.Time {
+	year, month, day := t.Date()
+	hour, minute, second := t.Clock()
+
+	return time.Date(year, month, day, hour, minute, second, t.Nanosecond(), time.UTC)
+}
+
+// localInstants returns the sorted instants at which the wall clock in location shows wall, a wall clock as returned by wallClock.
+// It returns no instant when wall falls in a gap (e.g., when clocks are moved forward for daylight saving time),
+// and two instants when it is repeated (e.g., when clocks are moved back).
+func localInstants(wall time.Time, location *time.Location) []time.Time {
+	var instants []time.Time
+
+	// Try the offsets in use around the wall clock.
+	offsets := map[int]bool{}
+	for _, probe := range []time.Time{wall.AddDate(0, 0, -1), wall, wall.AddDate(0, 0, 1)} {
+		_, offset := probe.In(location).Zone()
+		if offsets[offset] {
+			continue
+		}
+		offsets[offset] = true
+
+		instant := wall.Add(-time.Duration(offset) * time.Second).In(location)
+		if wallClock(instant).Equal(wall) {
+			instants = append(instants, instant)
+		}
+	}
+
+	slices.SortFunc(instants, func(a, b time.Time) int { return a.Compare(b) })
+
+	return instants
+}
+
+// fromWallClock returns the instant at which the wall clock in location shows wall, a wall clock as returned by wallClock.
+// A repeated wall clock resolves to its first occurrence, and a wall clock falling in a gap resolves to the end of the gap.
+func fromWallClock(wall time.Time, location *time.Location) time.Time {
+	instants := localInstants(wall, location)
+	if len(instants) > 0 {
+		return instants[0]
+	}
+
+	// Using the offset in use before the gap gives an instant after the transition.
+	_, offset := wall.AddDate(0, 0, -1).In(location).Zone()
+	start, _ := wall.Add(-time.Duration(offset) * time.Second).In(location).ZoneBounds()
+
+	return start
+}
@@ -0,0 +1,155 @@
+package datetime
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/TheoBrigitte/mcp-time/pkg/scheduling"
+)
+
+const (
+	// defaultMeetingRange is the length of the search range used when no end is specified.
+	defaultMeetingRange = 7 * 24 * time.Hour
+	// maxMeetingRange is the maximum length of the search range.
+	maxMeetingRange = 31 * 24 * time.Hour
+	// maxMeetingParticipants is the maximum number of participants.
+	maxMeetingParticipants = 50
+	// maxMeetingSlots is the maximum number of slots returned.
+	maxMeetingSlots = 100
+)
+
+// MeetingParticipant is a participant of a meeting, with its timezone and working hours.
+type MeetingParticipant struct {
+	// Name identifies the participant in the results. Defaults to the timezone.
+	Name string `json:"name,omitempty"`
+	// Timezone is the timezone of the participant.
+	Timezone string `json:"timezone"`
+	// WorkStart and WorkEnd are the working hours, as "HH:MM" (see WorldClock). Default to 09:00 and 17:00.
+	WorkStart string `json:"work_start,omitempty"`
+	WorkEnd   string `json:"work_end,omitempty"`
+	// Weekend lists the days of the week which are not working days. Defaults to Saturday and Sunday.
+	Weekend []string `json:"weekend,omitempty"`
+	// Country is an optional holiday calendar, whose public holidays are not working days.
+	Country string `json:"country,omitempty"`
+}
+
+// MeetingSlot is a candidate meeting slot.
+type MeetingSlot struct {
+	// Start and End are the bounds of the slot, in the timezone of the first participant.
+	Start string `json:"start"`
+	End   string `json:"end"`
+	// InHours is the number of participants for which the slot is within working hours.
+	InHours int `json:"in_hours"`
+	// Participants lists the local times of the slot for each participant.
+	Participants []MeetingAttendance `json:"participants"`
+}
+
+// MeetingAttendance is a meeting slot from the point of view of a participant.
+type MeetingAttendance struct {
+	// Name identifies the participant.
+	Name string `json:"name"`
+	// Start and End are the bounds of the slot, in the timezone of the participant.
+	Start string `json:"start"`
+	End   string `json:"end"`
+	// InHours is set when the slot is within the working hours of the participant.
+	InHours bool `json:"in_hours"`
+}
+
+// FindMeetingSlots returns up to limit meeting slots of the given duration between start and end, ranked by the number
+// of participants in working hours, then chronologically. Slots never overlap, and slots outside the working hours
+// of all participants are not returned.
+//
+// Input times without timezone are interpreted in the timezone of the first participant. The start defaults to the
+// current time, and the end to one week after the start. Times are returned in the specified format.
+func FindMeetingSlots(participants []MeetingParticipant, start, end, duration string, limit int, inputFormat InputFormat, format Format) ([]MeetingSlot, error) {
+	if len(participants) == 0 || len(participants) > maxMeetingParticipants {
+		return nil, fmt.Errorf("invalid_participants: Between 1 and %d participants are required", maxMeetingParticipants)
+	}
+	if limit < 1 || limit > maxMeetingSlots {
+		return nil, fmt.Errorf("invalid_limit: Limit must be between 1 and %d", maxMeetingSlots)
+	}
+
+	d, err := parseDuration(duration)
+	if err != nil {
+		return nil, fmt.Errorf("invalid_duration: Invalid duration format: %s", duration)
+	}
+	if d.years != 0 || d.months != 0 || d.days != 0 || d.clock <= 0 || d.clock > 24*time.Hour {
+		return nil, fmt.Errorf("invalid_duration: Meeting duration must be positive and at most 24 hours: %s", duration)
+	}
+
+	opts := scheduling.Options{Duration: d.clock, Limit: limit}
+	reference := participants[0].Timezone
+	startTime, err := fromStringWithTimezone(start, inputFormat, reference, "start")
+	if err != nil {
+		return nil, err
+	}
+	opts.Start = startTime.time
+	opts.End = opts.Start.Add(defaultMeetingRange)
+	if end != "" {
+		endTime, err := fromStringWithTimezone(end, inputFormat, reference, "end")
+		if err != nil {
+			return nil, err
+		}
+		opts.End = endTime.time
+	}
+	if !opts.Start.Before(opts.End) || opts.End.Sub(opts.Start) > maxMeetingRange {
+		return nil, fmt.Errorf("invalid_range: End must be after start, by at most %d days", maxMeetingRange/(24*time.Hour))
+	}
+
+	people := make([]scheduling.Participant, 0, len(participants))
+	for _, participant := range participants {
+		location, err := ResolveTimezone(participant.Timezone)
+		if err != nil {
+			return nil, err
+		}
+
+		workStart, workEnd, err := parseWorkingHours(participant.WorkStart, participant.WorkEnd)
+		if err != nil {
+			return nil, err
+		}
+
+		calendar, err := newBusinessCalendar(participant.Weekend, participant.Country)
+		if err != nil {
+			return nil, err
+		}
+
+		name := participant.Name
+		if name == "" {
+			name = location.String()
+		}
+
+		people = append(people, scheduling.Participant{
+			Name:       name,
+			Location:   location,
+			WorkStart:  workStart,
+			WorkEnd:    workEnd,
+			WorkingDay: calendar.isBusinessDay,
+		})
+	}
+
+	meetingSlots := []MeetingSlot{}
+	for _, slot := range scheduling.FindSlots(people, opts) {
+		s := MeetingSlot{InHours: slot.Count}
+		if s.Start, err = fromTime(slot.Start).format(format, reference); err != nil {
+			return nil, err
+		}
+		if s.End, err = fromTime(slot.End).format(format, reference); err != nil {
+			return nil, err
+		}
+
+		for i, p := range people {
+			a := MeetingAttendance{Name: p.Name, InHours: slot.InHours[i]}
+			if a.Start, err = fromTime(slot.Start.In(p.Location)).format(format, ""); err != nil {
+				return nil, err
+			}
+			if a.End, err = fromTime(slot.End.In(p.Location)).format(format, ""); err != nil {
+				return nil, err
+			}
+			s.Participants = append(s.Participants, a)
+		}
+
+		meetingSlots = append(meetingSlots, s)
+	}
+
+	return meetingSlots, nil
+}
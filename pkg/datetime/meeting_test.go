@@ -0,0 +1,106 @@
+package datetime
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestFindMeetingSlots tests the FindMeetingSlots function.
+func TestFindMeetingSlots(t *testing.T) {
+	participants := []MeetingParticipant{
+		{Name: "Alice", Timezone: "America/New_York"},
+		{Name: "Bob", Timezone: "Europe/London", WorkStart: "08:00", WorkEnd: "16:00"},
+		{Timezone: "Asia/Kolkata", WorkStart: "12:00", WorkEnd: "20:00"},
+	}
+
+	slots, err := FindMeetingSlots(participants, "2025-07-07", "2025-07-08", "30m", 2, InputFormat{}, Format{Layout: "RFC3339"})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	// Alice starts at 09:00 EDT, when Bob is in working hours until 11:00 EDT and Kolkata until 10:30 EDT.
+	expected := []MeetingSlot{
+		{
+			Start:   "2025-07-07T09:00:00-04:00",
+			End:     "2025-07-07T09:30:00-04:00",
+			InHours: 3,
+			Participants: []MeetingAttendance{
+				{"Alice", "2025-07-07T09:00:00-04:00", "2025-07-07T09:30:00-04:00", true},
+				{"Bob", "2025-07-07T14:00:00+01:00", "2025-07-07T14:30:00+01:00", true},
+				{"Asia/Kolkata", "2025-07-07T18:30:00+05:30", "2025-07-07T19:00:00+05:30", true},
+			},
+		},
+		{
+			Start:   "2025-07-07T09:30:00-04:00",
+			End:     "2025-07-07T10:00:00-04:00",
+			InHours: 3,
+		},
+	}
+
+	if len(slots) != len(expected) {
+		t.Fatalf("expected %d slots, got %+v", len(expected), slots)
+	}
+	for i := range expected {
+		if slots[i].Start != expected[i].Start || slots[i].End != expected[i].End || slots[i].InHours != expected[i].InHours {
+			t.Errorf("expected slot %s to %s with %d in hours, got %+v", expected[i].Start, expected[i].End, expected[i].InHours, slots[i])
+		}
+	}
+	for i, a := range expected[0].Participants {
+		if slots[0].Participants[i] != a {
+			t.Errorf("expected %+v, got %+v", a, slots[0].Participants[i])
+		}
+	}
+}
+
+// TestFindMeetingSlotsHolidays tests that public holidays are not working days.
+func TestFindMeetingSlotsHolidays(t *testing.T) {
+	participants := []MeetingParticipant{
+		{Timezone: "America/New_York", Country: "US"},
+		{Timezone: "Europe/Paris"},
+	}
+
+	// 4 July 2025 is Independence Day in the United States.
+	slots, err := FindMeetingSlots(participants, "2025-07-04", "2025-07-05", "1h", 10, InputFormat{}, Format{})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	for _, slot := range slots {
+		if slot.InHours != 1 || slot.Participants[0].InHours {
+			t.Errorf("expected only Paris in working hours, got %+v", slot)
+		}
+	}
+}
+
+// TestFindMeetingSlotsInvalid tests that FindMeetingSlots rejects invalid arguments.
+func TestFindMeetingSlotsInvalid(t *testing.T) {
+	paris := []MeetingParticipant{{Timezone: "Europe/Paris"}}
+
+	tests := []struct {
+		name           string
+		participants   []MeetingParticipant
+		start, end     string
+		duration       string
+		limit          int
+		expectedPrefix string
+	}{
+		{"no participants", nil, "", "", "1h", 5, "invalid_participants:"},
+		{"invalid timezone", []MeetingParticipant{{Timezone: "Mars/Olympus"}}, "2025-07-07", "", "1h", 5, "invalid_timezone:"},
+		{"invalid hours", []MeetingParticipant{{Timezone: "UTC", WorkStart: "25:00"}}, "", "", "1h", 5, "invalid_working_hours:"},
+		{"calendar duration", paris, "", "", "1d", 5, "invalid_duration:"},
+		{"negative duration", paris, "", "", "-30m", 5, "invalid_duration:"},
+		{"invalid duration", paris, "", "", "half an hour", 5, "invalid_duration:"},
+		{"reversed range", paris, "2025-07-08", "2025-07-07", "1h", 5, "invalid_range:"},
+		{"long range", paris, "2025-07-01", "2025-09-01", "1h", 5, "invalid_range:"},
+		{"invalid limit", paris, "", "", "1h", 0, "invalid_limit:"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := FindMeetingSlots(test.participants, test.start, test.end, test.duration, test.limit, InputFormat{}, Format{})
+			if err == nil || !strings.HasPrefix(err.Error(), test.expectedPrefix) {
+				t.Errorf("expected %s error, got %v", test.expectedPrefix, err)
+			}
+		})
+	}
+}
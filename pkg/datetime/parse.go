@@ -0,0 +1,194 @@
+package datetime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/araddon/dateparse"
+)
+
+// ParsedTime reports how an input time was read.
+type ParsedTime struct {
+	// Time is the parsed instant, in the requested format (RFC 3339 by default) and in the timezone of the time.
+	Time string `json:"time"`
+	// Layout is the Go layout the input time was read with (e.g., "01/02/2006 15:04"),
+	// or the name of its Unix timestamp format (e.g., "UnixMilli").
+	Layout string `json:"layout"`
+	// Timezone is the name of the timezone of the time: the timezone of the input time when present,
+	// or the timezone it was interpreted in otherwise.
+	Timezone string `json:"timezone"`
+	// TimezonePresent is set when the input time carries its own timezone or UTC offset, or is a Unix timestamp.
+	TimezonePresent bool `json:"timezone_present"`
+	// Warnings lists the assumptions made to read the input time, as "code: Message" strings:
+	// ambiguous_date_order, nonexistent_time, repeated_time and unknown_timezone_abbreviation.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// ParseTime parses an input time and reports the layout it was read with, whether it carries its own timezone,
+// and warnings about the assumptions made to read it: a numeric date which could be read in another order, a local
+// time which does not exist or is repeated because of a daylight saving time change, and a timezone abbreviation
+// which was read as UTC. Input times without timezone are interpreted in the given timezone.
+// Unlike the other functions, the input time is required and does not default to the current time.
+func ParseTime(inputTime, timezone string, inputFormat InputFormat, format Format) (*ParsedTime, error) {
+	if strings.TrimSpace(inputTime) == "" {
+		return nil, fmt.Errorf("missing_time: A time is required")
+	}
+
+	location, err := ResolveTimezone(timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	_, unit, err := inputFormat.layout()
+	if err != nil {
+		return nil, err
+	}
+
+	guessed := inputFormat.Layout == "" && inputFormat.DateOrder == ""
+	dt, err := fromStringWithFormat(inputTime, inputFormat, location)
+	if err != nil {
+		// Hint at the date order when a numeric date can only be read day first.
+		if m := numericDate.FindStringSubmatch(inputTime); guessed && m != nil && len(m[1]) <= 2 {
+			if day, _ := strconv.Atoi(m[1]); day > 12 && day <= 31 {
+				return nil, fmt.Errorf("%s (%s is not a month, set date_order to DMY to read the day first)", err, m[1])
+			}
+		}
+		return nil, err
+	}
+
+	// Read the input time again in a fixed offset which no timezone uses, to know whether it carries its own timezone.
+	probe, err := fromStringWithFormat(inputTime, inputFormat, time.FixedZone("", -(11*60+11)*60))
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := fromTime(dt.time).format(format, "")
+	if err != nil {
+		return nil, err
+	}
+
+	parsed := &ParsedTime{
+		Time:     output,
+		Layout:   dt.layout,
+		Timezone: dt.time.Location().String(),
+	}
+	if parsed.Timezone == "" {
+		_, offset := dt.time.Zone()
+		parsed.Timezone = "UTC" + formatOffset(offset)
+	}
+
+	switch {
+	case unit != 0:
+		parsed.Layout = epochName(unit)
+	case parsed.Layout == "":
+		// dateparse reads integers as Unix timestamps, at a precision given by their number of digits.
+		if strings.Trim(inputTime, "0123456789") == "" {
+			for _, name := range getEpochFormats() {
+				if t, err := parseEpoch(inputTime, epochUnits[name]); err == nil && t.Equal(dt.time) {
+					parsed.Layout = name
+				}
+			}
+		} else {
+			parsed.Layout, _ = dateparse.ParseFormat(inputTime)
+		}
+	}
+
+	// The input time carries its own timezone when it is read as the same instant in the probe offset,
+	// or when its layout has a zone, whose abbreviation may only be known in the timezone (e.g., "PST").
+	present := unit != 0 || probe.time.Equal(dt.time) || strings.Contains(parsed.Layout, "MST")
+	parsed.TimezonePresent = present
+
+	if guessed {
+		if warning := dateOrderWarning(inputTime, dt.time); warning != "" {
+			parsed.Warnings = append(parsed.Warnings, warning)
+		}
+	}
+
+	if !present {
+		// The wall clock written in the input time, as read in the fixed probe timezone.
+		wall := wallClock(probe.time)
+		switch instants := localInstants(wall, location); len(instants) {
+		case 0:
+			parsed.Warnings = append(parsed.Warnings, fmt.Sprintf("nonexistent_time: %s does not exist in %s, where it is skipped when the clocks are moved forward; it was read as %s",
+				wall.Format(time.DateTime), location, dt.time.Format(time.RFC3339)))
+		case 2:
+			parsed.Warnings = append(parsed.Warnings, fmt.Sprintf("repeated_time: %s occurs twice in %s, at %s and %s, when the clocks are moved back; it was read as %s",
+				wall.Format(time.DateTime), location, instants[0].Format(time.RFC3339), instants[1].Format(time.RFC3339), dt.time.Format(time.RFC3339)))
+		}
+	} else if unit == 0 {
+		if warning := abbreviationWarning(dt.time); warning != "" {
+			parsed.Warnings = append(parsed.Warnings, warning)
+		}
+	}
+
+	return parsed, nil
+}
+
+// epochName returns the name of the Unix timestamp format of the given precision.
+func epochName(unit time.Duration) string {
+	for name, u := range epochUnits {
+		if u == unit {
+			return name
+		}
+	}
+
+	return ""
+}
+
+// dateOrderWarning returns a warning when the numeric date of the input time, read as t, could be read in another
+// order (e.g., "01/02/2024" as January 2 or February 1), or an empty string otherwise.
+func dateOrderWarning(inputTime string, t time.Time) string {
+	m := numericDate.FindStringSubmatch(inputTime)
+	if m == nil || m[2] != m[4] || len(m[1]) > 2 || len(m[3]) > 2 {
+		return ""
+	}
+
+	first, _ := strconv.Atoi(m[1])
+	second, _ := strconv.Atoi(m[3])
+	if first == second || first < 1 || first > 12 || second < 1 || second > 12 {
+		return ""
+	}
+
+	read, order, other := "month first", "DMY", time.Date(t.Year(), time.Month(second), first, 0, 0, 0, 0, time.UTC)
+	if int(t.Month()) != first {
+		read, order, other = "day first", "MDY", time.Date(t.Year(), time.Month(first), second, 0, 0, 0, 0, time.UTC)
+	}
+	date := m[0][:len(m[0])-len(m[6])]
+
+	return fmt.Sprintf("ambiguous_date_order: %s was read %s, as %s; set date_order to %s to read it as %s",
+		date, read, t.Format(time.DateOnly), order, other.Format(time.DateOnly))
+}
+
+// abbreviationWarning returns a warning when the timezone abbreviation of t was not known by the parser, which then
+// reads it as UTC (e.g., "EST" in a time interpreted in Europe/Paris), or an empty string otherwise.
+func abbreviationWarning(t time.Time) string {
+	name, offset := t.Zone()
+	if offset != 0 || name == "" || name == "UTC" || name == "GMT" || name == "Z" || t.Location().String() != name {
+		return ""
+	}
+
+	// The abbreviation may be a timezone name (e.g., "EST"), or have several meanings (e.g., "CST").
+	var offsets []string
+	if location, err := ResolveTimezone(name); err == nil {
+		_, usual := t.In(location).Zone()
+		if usual == 0 {
+			return ""
+		}
+		offsets = append(offsets, "UTC"+formatOffset(usual))
+	} else {
+		for _, c := range timezoneAbbreviations[strings.ToUpper(name)] {
+			if c.offset == 0 {
+				return ""
+			}
+			offsets = append(offsets, "UTC"+formatOffset(c.offset))
+		}
+	}
+	meaning := "is not a known abbreviation"
+	if len(offsets) > 0 {
+		meaning = "usually means " + strings.Join(offsets, " or ")
+	}
+
+	return fmt.Sprintf("unknown_timezone_abbreviation: %s was read as UTC+00:00, while it %s; use a UTC offset instead", name, meaning)
+}
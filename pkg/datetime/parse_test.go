@@ -0,0 +1,95 @@
+package datetime
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+// TestParseTime tests the report of how input times are read.
+func TestParseTime(t *testing.T) {
+	tests := []struct {
+		inputTime        string
+		timezone         string
+		inputFormat      InputFormat
+		expectedTime     string
+		expectedLayout   string
+		expectedTimezone string
+		expectedPresent  bool
+		// expectedWarnings are the codes of the warnings.
+		expectedWarnings []string
+	}{
+		{"2025-07-08 10:00", "", InputFormat{}, "2025-07-08T10:00:00Z", "2006-01-02 15:04", "UTC", false, nil},
+		{"2025-07-08T10:00:00+05:30", "Europe/Paris", InputFormat{}, "2025-07-08T10:00:00+05:30", "2006-01-02T15:04:05-07:00", "UTC+05:30", true, nil},
+		{"01/02/2024 10:30", "Europe/Paris", InputFormat{}, "2024-01-02T10:30:00+01:00", "01/02/2006 15:04", "Europe/Paris", false, []string{"ambiguous_date_order"}},
+		{"01/01/2024", "", InputFormat{}, "2024-01-01T00:00:00Z", "01/02/2006", "UTC", false, nil},
+		{"01/02/2024", "", InputFormat{DateOrder: "DMY"}, "2024-02-01T00:00:00Z", "02/01/2006", "UTC", false, nil},
+		{"01/02/2024", "", InputFormat{Layout: "02/01/2006"}, "2024-02-01T00:00:00Z", "02/01/2006", "UTC", false, nil},
+		{"2025-03-30 02:30", "Europe/Paris", InputFormat{}, "2025-03-30T03:30:00+02:00", "2006-01-02 15:04", "Europe/Paris", false, []string{"nonexistent_time"}},
+		{"2025-03-09 02:30", "America/New_York", InputFormat{}, "2025-03-09T01:30:00-05:00", "2006-01-02 15:04", "America/New_York", false, []string{"nonexistent_time"}},
+		{"2025-10-26 02:30", "Europe/Paris", InputFormat{}, "2025-10-26T02:30:00+01:00", "2006-01-02 15:04", "Europe/Paris", false, []string{"repeated_time"}},
+		{"2025-10-26 02:30 +02:00", "Europe/Paris", InputFormat{}, "2025-10-26T02:30:00+02:00", "2006-01-02 15:04 -07:00", "Europe/Paris", true, nil},
+		{"2025-07-08 10:00 EST", "Europe/Paris", InputFormat{}, "2025-07-08T10:00:00Z", "2006-01-02 15:04 MST", "EST", true, []string{"unknown_timezone_abbreviation"}},
+		{"2025-07-08 10:00 PST", "America/Los_Angeles", InputFormat{}, "2025-07-08T11:00:00-07:00", "2006-01-02 15:04 MST", "America/Los_Angeles", true, nil},
+		{"1751968800123", "", InputFormat{}, "2025-07-08T10:00:00Z", "UnixMilli", "UTC", true, nil},
+		{"1751968800", "Europe/Paris", InputFormat{Layout: "Unix"}, "2025-07-08T10:00:00Z", "Unix", "UTC", true, nil},
+		{"1751968800", "", InputFormat{Layout: "strftime:%s"}, "2025-07-08T10:00:00Z", "Unix", "UTC", true, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.inputTime, func(t *testing.T) {
+			parsed, err := ParseTime(test.inputTime, test.timezone, test.inputFormat, Format{})
+			if err != nil {
+				t.Errorf("unexpected error %v", err)
+				return
+			}
+
+			if parsed.Time != test.expectedTime {
+				t.Errorf("expected time %q, got %q", test.expectedTime, parsed.Time)
+			}
+			if parsed.Layout != test.expectedLayout {
+				t.Errorf("expected layout %q, got %q", test.expectedLayout, parsed.Layout)
+			}
+			if parsed.Timezone != test.expectedTimezone {
+				t.Errorf("expected timezone %q, got %q", test.expectedTimezone, parsed.Timezone)
+			}
+			if parsed.TimezonePresent != test.expectedPresent {
+				t.Errorf("expected timezone present %v, got %v", test.expectedPresent, parsed.TimezonePresent)
+			}
+
+			var codes []string
+			for _, warning := range parsed.Warnings {
+				code, _, _ := strings.Cut(warning, ":")
+				codes = append(codes, code)
+			}
+			if !slices.Equal(codes, test.expectedWarnings) {
+				t.Errorf("expected warnings %v, got %v", test.expectedWarnings, parsed.Warnings)
+			}
+		})
+	}
+}
+
+// TestParseTimeInvalid tests that invalid input times are reported with a hint when possible.
+func TestParseTimeInvalid(t *testing.T) {
+	tests := []struct {
+		inputTime    string
+		inputFormat  InputFormat
+		expectedText string
+	}{
+		{"31/12/2025", InputFormat{}, "set date_order to DMY"},
+		{"not a time", InputFormat{}, "invalid_time:"},
+		{"2025-07-08", InputFormat{Layout: "Unix"}, "invalid_time:"},
+		{"2025-07-08", InputFormat{DateOrder: "DDMMYY"}, "invalid_date_order:"},
+		{"", InputFormat{}, "missing_time:"},
+		{" ", InputFormat{Layout: "Unix"}, "missing_time:"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.inputTime, func(t *testing.T) {
+			_, err := ParseTime(test.inputTime, "", test.inputFormat, Format{})
+			if err == nil || !strings.Contains(err.Error(), test.expectedText) {
+				t.Errorf("expected error containing %q, got %v", test.expectedText, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,319 @@
+package datetime
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/TheoBrigitte/mcp-time/pkg/locale"
+)
+
+// patternElement writes an element of a time, with the names of the locale.
+type patternElement func(t time.Time, l *locale.Locale) string
+
+// patternLiteral returns an element writing a literal text.
+func patternLiteral(s string) patternElement {
+	return func(time.Time, *locale.Locale) string { return s }
+}
+
+// patternNumber returns an element writing a number padded with zeros to width.
+func patternNumber(width int, value func(t time.Time) int) patternElement {
+	return func(t time.Time, _ *locale.Locale) string {
+		return padNumber(int64(value(t)), width, '0', 0)
+	}
+}
+
+// patternLayout returns an element writing a Go layout.
+func patternLayout(layout string) patternElement {
+	return func(t time.Time, l *locale.Locale) string { return l.Format(t, layout) }
+}
+
+// patternStyle returns an element writing a pattern of the locale (e.g., "DateLong").
+func patternStyle(name string) patternElement {
+	return func(t time.Time, l *locale.Locale) string {
+		layout, _ := l.Layout(name)
+		return l.Format(t, layout)
+	}
+}
+
+// patternFraction returns an element writing the first digits of the fractional second.
+func patternFraction(digits int) patternElement {
+	return func(t time.Time, _ *locale.Locale) string {
+		s := fmt.Sprintf("%09d", t.Nanosecond())
+		if digits > len(s) {
+			return s + strings.Repeat("0", digits-len(s))
+		}
+		return s[:digits]
+	}
+}
+
+// Values of a time used by the pattern elements.
+var (
+	yearOf         = func(t time.Time) int { return t.Year() }
+	shortYearOf    = func(t time.Time) int { return t.Year() % 100 }
+	isoYearOf      = func(t time.Time) int { y, _ := t.ISOWeek(); return y }
+	shortISOYearOf = func(t time.Time) int { return isoYearOf(t) % 100 }
+	isoWeekOf      = func(t time.Time) int { _, w := t.ISOWeek(); return w }
+	quarterOf      = func(t time.Time) int { return (int(t.Month())-1)/3 + 1 }
+	monthOf        = func(t time.Time) int { return int(t.Month()) }
+	dayOf          = func(t time.Time) int { return t.Day() }
+	yearDayOf      = func(t time.Time) int { return t.YearDay() }
+	weekdayOf      = func(t time.Time) int { return int(t.Weekday()) }
+	isoWeekdayOf   = func(t time.Time) int { return (int(t.Weekday())+6)%7 + 1 }
+	hourOf         = func(t time.Time) int { return t.Hour() }
+	hour12Of       = func(t time.Time) int { return (t.Hour()+11)%12 + 1 }
+	hour11Of       = func(t time.Time) int { return t.Hour() % 12 }
+	hour24Of       = func(t time.Time) int { return cmp.Or(t.Hour(), 24) }
+	minuteOf       = func(t time.Time) int { return t.Minute() }
+	secondOf       = func(t time.Time) int { return t.Second() }
+)
+
+// patternZone writes the timezone abbreviation.
+var patternZone patternElement = func(t time.Time, _ *locale.Locale) string { return t.Format("MST") }
+
+// patternOrdinal writes a number with its English ordinal suffix (e.g., "1st", "22nd").
+func patternOrdinal(value func(t time.Time) int) patternElement {
+	return func(t time.Time, _ *locale.Locale) string {
+		n := value(t)
+		suffix := "th"
+		if n%100 < 11 || n%100 > 13 {
+			switch n % 10 {
+			case 1:
+				suffix = "st"
+			case 2:
+				suffix = "nd"
+			case 3:
+				suffix = "rd"
+			}
+		}
+		return strconv.Itoa(n) + suffix
+	}
+}
+
+// formatElements writes a time with the elements of a pattern.
+func formatElements(t time.Time, elements []patternElement, l *locale.Locale) string {
+	l = cmp.Or(l, english)
+
+	var b strings.Builder
+	for _, e := range elements {
+		b.WriteString(e(t, l))
+	}
+
+	return b.String()
+}
+
+// icuElement returns the element of an ICU (LDML) pattern field, a letter repeated count times, or false if it is not supported.
+// Weekday numbers and weeks follow ISO 8601 (weeks start on Monday), whatever the locale.
+func icuElement(letter byte, count int) (patternElement, bool) {
+	switch {
+	case letter == 'G' && count <= 3:
+		return func(t time.Time, _ *locale.Locale) string {
+			if t.Year() <= 0 {
+				return "BC"
+			}
+			return "AD"
+		}, true
+	case (letter == 'y' || letter == 'u') && count == 2:
+		return patternNumber(2, shortYearOf), true
+	case letter == 'y' || letter == 'u':
+		return patternNumber(count, yearOf), true
+	case letter == 'Y' && count == 2:
+		return patternNumber(2, shortISOYearOf), true
+	case letter == 'Y':
+		return patternNumber(count, isoYearOf), true
+	case (letter == 'Q' || letter == 'q') && count <= 2:
+		return patternNumber(count, quarterOf), true
+	case (letter == 'Q' || letter == 'q') && count == 3:
+		return func(t time.Time, _ *locale.Locale) string { return "Q" + strconv.Itoa(quarterOf(t)) }, true
+	case (letter == 'M' || letter == 'L') && count <= 2:
+		return patternNumber(count, monthOf), true
+	case (letter == 'M' || letter == 'L') && count == 3:
+		return patternLayout("Jan"), true
+	case (letter == 'M' || letter == 'L') && count == 4:
+		return patternLayout("January"), true
+	case letter == 'w' && count <= 2:
+		return patternNumber(count, isoWeekOf), true
+	case letter == 'd' && count <= 2:
+		return patternNumber(count, dayOf), true
+	case letter == 'D' && count <= 3:
+		return patternNumber(count, yearDayOf), true
+	case (letter == 'e' || letter == 'c') && count <= 2:
+		return patternNumber(count, isoWeekdayOf), true
+	case (letter == 'E' && count <= 3) || ((letter == 'e' || letter == 'c') && count == 3):
+		return patternLayout("Mon"), true
+	case (letter == 'E' || letter == 'e' || letter == 'c') && count == 4:
+		return patternLayout("Monday"), true
+	case letter == 'a' && count <= 3:
+		return patternLayout("PM"), true
+	case letter == 'h' && count <= 2:
+		return patternNumber(count, hour12Of), true
+	case letter == 'H' && count <= 2:
+		return patternNumber(count, hourOf), true
+	case letter == 'K' && count <= 2:
+		return patternNumber(count, hour11Of), true
+	case letter == 'k' && count <= 2:
+		return patternNumber(count, hour24Of), true
+	case letter == 'm' && count <= 2:
+		return patternNumber(count, minuteOf), true
+	case letter == 's' && count <= 2:
+		return patternNumber(count, secondOf), true
+	case letter == 'S':
+		return patternFraction(count), true
+	case letter == 'z' && count <= 3:
+		return patternZone, true
+	case letter == 'Z' && count <= 3:
+		return patternLayout("-0700"), true
+	case letter == 'Z' && count == 4:
+		return func(t time.Time, _ *locale.Locale) string {
+			if _, offset := t.Zone(); offset == 0 {
+				return "GMT"
+			}
+			return "GMT" + t.Format("-07:00")
+		}, true
+	case letter == 'Z' && count == 5:
+		return patternLayout("Z07:00"), true
+	case letter == 'X' && count <= 3:
+		return patternLayout([]string{"Z07", "Z0700", "Z07:00"}[count-1]), true
+	case letter == 'x' && count <= 3:
+		return patternLayout([]string{"-07", "-0700", "-07:00"}[count-1]), true
+	}
+
+	return nil, false
+}
+
+// compileICU translates an ICU (LDML) date pattern (e.g., "yyyy-MM-dd'T'HH:mm:ss.SSSXXX") to its elements.
+// Letters are pattern fields, and literal text is quoted with apostrophes ("”" is an apostrophe).
+func compileICU(pattern string) ([]patternElement, error) {
+	var elements []patternElement
+	for i := 0; i < len(pattern); {
+		c := pattern[i]
+		switch {
+		case c == '\'' && strings.HasPrefix(pattern[i:], "''"):
+			elements = append(elements, patternLiteral("'"))
+			i += 2
+		case c == '\'':
+			// Quoted literal text, in which "''" is an apostrophe.
+			var text strings.Builder
+			i++
+			for {
+				end := strings.IndexByte(pattern[i:], '\'')
+				if end < 0 {
+					return nil, fmt.Errorf("invalid_format: Unterminated quote in ICU pattern: %s", pattern)
+				}
+				text.WriteString(pattern[i : i+end])
+				i += end + 1
+				if !strings.HasPrefix(pattern[i:], "'") {
+					break
+				}
+				text.WriteByte('\'')
+				i++
+			}
+			elements = append(elements, patternLiteral(text.String()))
+		case 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z':
+			count := 1
+			for i+count < len(pattern) && pattern[i+count] == c {
+				count++
+			}
+			e, ok := icuElement(c, count)
+			if !ok {
+				return nil, fmt.Errorf("invalid_format: Unsupported ICU pattern field %q, quote literal letters with apostrophes", pattern[i:i+count])
+			}
+			elements = append(elements, e)
+			i += count
+		default:
+			_, size := utf8.DecodeRuneInString(pattern[i:])
+			elements = append(elements, patternLiteral(pattern[i:i+size]))
+			i += size
+		}
+	}
+
+	return elements, nil
+}
+
+// momentTokens maps the Moment.js format tokens to their element, or nil for the tokens which are not supported.
+// Ordinals are written in English, and weeks and weekday numbers follow ISO 8601 ("W", "GGGG", "E").
+var momentTokens = map[string]patternElement{
+	"M": patternNumber(1, monthOf), "MM": patternNumber(2, monthOf), "MMM": patternLayout("Jan"), "MMMM": patternLayout("January"), "Mo": patternOrdinal(monthOf),
+	"Q": patternNumber(1, quarterOf), "Qo": patternOrdinal(quarterOf),
+	"D": patternNumber(1, dayOf), "DD": patternNumber(2, dayOf), "Do": patternOrdinal(dayOf),
+	"DDD": patternNumber(1, yearDayOf), "DDDD": patternNumber(3, yearDayOf), "DDDo": patternOrdinal(yearDayOf),
+	"d": patternNumber(1, weekdayOf), "do": patternOrdinal(weekdayOf), "dd": nil, "ddd": patternLayout("Mon"), "dddd": patternLayout("Monday"),
+	"e": nil, "E": patternNumber(1, isoWeekdayOf),
+	"w": nil, "wo": nil, "ww": nil,
+	"W": patternNumber(1, isoWeekOf), "WW": patternNumber(2, isoWeekOf), "Wo": patternOrdinal(isoWeekOf),
+	"Y": patternNumber(1, yearOf), "YY": patternNumber(2, shortYearOf), "YYYY": patternNumber(4, yearOf), "YYYYY": nil, "YYYYYY": nil,
+	"y": nil, "yo": nil, "yy": nil, "yyyy": nil,
+	"gg": nil, "gggg": nil, "ggggg": nil,
+	"GG": patternNumber(2, shortISOYearOf), "GGGG": patternNumber(4, isoYearOf), "GGGGG": nil,
+	"N": nil, "NN": nil, "NNN": nil, "NNNN": nil, "NNNNN": nil,
+	"A": patternLayout("PM"), "a": patternLayout("pm"),
+	"H": patternNumber(1, hourOf), "HH": patternNumber(2, hourOf), "h": patternNumber(1, hour12Of), "hh": patternNumber(2, hour12Of),
+	"k": patternNumber(1, hour24Of), "kk": patternNumber(2, hour24Of),
+	"m": patternNumber(1, minuteOf), "mm": patternNumber(2, minuteOf), "s": patternNumber(1, secondOf), "ss": patternNumber(2, secondOf),
+	"S": patternFraction(1), "SS": patternFraction(2), "SSS": patternFraction(3), "SSSS": patternFraction(4), "SSSSS": patternFraction(5),
+	"SSSSSS": patternFraction(6), "SSSSSSS": patternFraction(7), "SSSSSSSS": patternFraction(8), "SSSSSSSSS": patternFraction(9),
+	"z": patternZone, "zz": patternZone, "Z": patternLayout("-07:00"), "ZZ": patternLayout("-0700"),
+	"X":  func(t time.Time, _ *locale.Locale) string { return strconv.FormatInt(t.Unix(), 10) },
+	"x":  func(t time.Time, _ *locale.Locale) string { return strconv.FormatInt(t.UnixMilli(), 10) },
+	"LT": patternStyle("TimeShort"), "LTS": patternStyle("TimeMedium"),
+	"L": patternStyle("DateShort"), "LL": patternStyle("DateLong"), "LLL": patternStyle("DateTimeLong"), "LLLL": patternStyle("DateTimeFull"),
+	"l": nil, "ll": nil, "lll": nil, "llll": nil,
+}
+
+// momentTokenList lists the Moment.js format tokens, the longest first.
+var momentTokenList = slices.SortedFunc(maps.Keys(momentTokens), func(a, b string) int {
+	return cmp.Or(cmp.Compare(len(b), len(a)), cmp.Compare(a, b))
+})
+
+// compileMoment translates a Moment.js format (e.g., "YYYY-MM-DD HH:mm") to its elements.
+// Text which is not a token is literal, and literal text may be escaped with brackets (e.g., "[at] HH:mm").
+func compileMoment(format string) ([]patternElement, error) {
+	var elements []patternElement
+next:
+	for i := 0; i < len(format); {
+		if format[i] == '[' {
+			end := strings.IndexByte(format[i:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("invalid_format: Unterminated bracket in Moment.js format: %s", format)
+			}
+			elements = append(elements, patternLiteral(format[i+1:i+end]))
+			i += end + 1
+			continue
+		}
+
+		for _, token := range momentTokenList {
+			if strings.HasPrefix(format[i:], token) {
+				e := momentTokens[token]
+				if e == nil {
+					return nil, fmt.Errorf("invalid_format: Unsupported Moment.js token %q", token)
+				}
+				elements = append(elements, e)
+				i += len(token)
+				continue next
+			}
+		}
+
+		_, size := utf8.DecodeRuneInString(format[i:])
+		elements = append(elements, patternLiteral(format[i:i+size]))
+		i += size
+	}
+
+	return elements, nil
+}
+
+// patternFormatter returns the formatter of a pattern dialect.
+func patternFormatter(compile func(pattern string) ([]patternElement, error)) func(t time.Time, pattern string, l *locale.Locale) (string, error) {
+	return func(t time.Time, pattern string, l *locale.Locale) (string, error) {
+		elements, err := compile(pattern)
+		if err != nil {
+			return "", err
+		}
+
+		return formatElements(t, elements, l), nil
+	}
+}
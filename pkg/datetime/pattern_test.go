@@ -0,0 +1,133 @@
+package datetime
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestFormatICU tests the ICU (LDML) pattern fields.
+func TestFormatICU(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("invalid location: %v", err)
+	}
+
+	// Monday, in the first ISO week of 2025.
+	monday := time.Date(2024, time.December, 30, 15, 4, 5, 123456789, newYork)
+	midnight := time.Date(2025, time.July, 8, 0, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		time     time.Time
+		pattern  string
+		locale   string
+		expected string
+	}{
+		{monday, "yyyy-MM-dd'T'HH:mm:ss.SSSXXX", "", "2024-12-30T15:04:05.123-05:00"},
+		{midnight, "yyyy-MM-dd'T'HH:mm:ss.SSSXXX", "", "2025-07-08T00:30:00.000Z"},
+		{monday, "y yy yyyy u G", "", "2024 24 2024 2024 AD"},
+		{monday, "YYYY-'W'ww-e YY", "", "2025-W01-1 25"},
+		{monday, "Q QQ QQQ q", "", "4 04 Q4 4"},
+		{monday, "M MM MMM MMMM L", "", "12 12 Dec December 12"},
+		{monday, "d dd D DDD", "", "30 30 365 365"},
+		{monday, "E EEE EEEE ee ccc", "", "Mon Mon Monday 01 Mon"},
+		{monday, "h hh H HH K k a", "", "3 03 15 15 3 15 PM"},
+		{midnight, "h H K k a", "", "12 0 0 24 AM"},
+		{monday, "m mm s ss S SS SSSSSS", "", "4 04 5 05 1 12 123456"},
+		{monday, "z Z ZZZZ ZZZZZ X XX XXX x xx xxx", "", "EST -0500 GMT-05:00 -05:00 -05 -0500 -05:00 -05 -0500 -05:00"},
+		{midnight, "ZZZZ X xxx", "", "GMT Z +00:00"},
+		{monday, "'o''clock' h 'o''''clock' ''", "", "o'clock 3 o''clock '"},
+		{monday, "EEEE d MMMM yyyy, HH:mm", "fr", "lundi 30 décembre 2024, 15:04"},
+		{monday, "EEE d. MMM", "de", "Mo. 30. Dez."},
+		{monday, "yyyy年M月d日 a h時", "ja", "2024年12月30日 午後 3時"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.pattern+" "+test.locale, func(t *testing.T) {
+			output, err := dateTime{time: test.time}.format(Format{Layout: test.pattern, Locale: test.locale, Style: "icu"}, "")
+			if err != nil {
+				t.Errorf("unexpected error %v", err)
+				return
+			}
+
+			if output != test.expected {
+				t.Errorf("expected output %q, got %q", test.expected, output)
+			}
+		})
+	}
+}
+
+// TestFormatMoment tests the Moment.js format tokens.
+func TestFormatMoment(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("invalid location: %v", err)
+	}
+
+	monday := time.Date(2024, time.December, 30, 15, 4, 5, 123456789, newYork)
+	first := time.Date(2025, time.July, 1, 0, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		time     time.Time
+		format   string
+		locale   string
+		expected string
+	}{
+		{monday, "YYYY-MM-DD", "", "2024-12-30"},
+		{monday, "YYYY-MM-DDTHH:mm:ss.SSSZ", "", "2024-12-30T15:04:05.123-05:00"},
+		{monday, "Y YY M MM MMM MMMM Q", "", "2024 24 12 12 Dec December 4"},
+		{monday, "D DD DDD DDDD d E ddd dddd", "", "30 30 365 365 1 1 Mon Monday"},
+		{monday, "GGGG-[W]WW-E GG W", "", "2025-W01-1 25 1"},
+		{monday, "H HH h hh k kk A a", "", "15 15 3 03 15 15 PM pm"},
+		{first, "h k a", "", "12 24 am"},
+		{monday, "m mm s ss S SS SSSSSSSSS", "", "4 04 5 05 1 12 123456789"},
+		{monday, "z Z ZZ X x", "", "EST -05:00 -0500 1735589045 1735589045123"},
+		{first, "Do Mo Qo DDDo", "", "1st 7th 3rd 182nd"},
+		{time.Date(2025, time.July, 12, 0, 0, 0, 0, time.UTC), "Do", "", "12th"},
+		{time.Date(2025, time.July, 23, 0, 0, 0, 0, time.UTC), "Do", "", "23rd"},
+		{monday, "dddd, MMMM Do YYYY [at] h:mm A", "", "Monday, December 30th 2024 at 3:04 PM"},
+		{monday, "LT|LTS|L|LL|LLL|LLLL", "", "3:04 PM|3:04:05 PM|12/30/24|December 30, 2024|December 30, 2024, 3:04:05 PM|Monday, December 30, 2024, 3:04:05 PM"},
+		{monday, "LL [à] HH[h]mm", "fr", "30 décembre 2024 à 15h04"},
+		{monday, "dddd D. MMMM", "de", "Montag 30. Dezember"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.format+" "+test.locale, func(t *testing.T) {
+			output, err := dateTime{time: test.time}.format(Format{Layout: test.format, Locale: test.locale, Style: "moment"}, "")
+			if err != nil {
+				t.Errorf("unexpected error %v", err)
+				return
+			}
+
+			if output != test.expected {
+				t.Errorf("expected output %q, got %q", test.expected, output)
+			}
+		})
+	}
+}
+
+// TestFormatPatternInvalid tests that unsupported tokens of the pattern dialects are rejected.
+func TestFormatPatternInvalid(t *testing.T) {
+	tests := []struct {
+		style        string
+		pattern      string
+		expectedText string
+	}{
+		{"icu", "yyyy-MM-ddTHH:mm", `"T"`},
+		{"icu", "MMMMM", `"MMMMM"`},
+		{"icu", "zzzz", `"zzzz"`},
+		{"icu", "'unterminated", "Unterminated quote"},
+		{"moment", "dd", `"dd"`},
+		{"moment", "gggg-ww", `"gggg"`},
+		{"moment", "[unterminated", "Unterminated bracket"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.style+" "+test.pattern, func(t *testing.T) {
+			_, err := fromTime(time.Now()).format(Format{Layout: test.pattern, Style: test.style}, "")
+			if err == nil || !strings.HasPrefix(err.Error(), "invalid_format:") || !strings.Contains(err.Error(), test.expectedText) {
+				t.Errorf("expected invalid_format error containing %s, got %v", test.expectedText, err)
+			}
+		})
+	}
+}
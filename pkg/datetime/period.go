@@ -0,0 +1,204 @@
+package datetime
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// defaultWeekStart is the first day of the week used when none is specified.
+const defaultWeekStart = time.Monday
+
+// periodUnits lists the units of PeriodBounds.
+var periodUnits = []string{"minute", "hour", "day", "week", "isoweek", "month", "quarter", "year"}
+
+// maxPeriodOffsetYears is the maximum offset of PeriodBounds, in years.
+const maxPeriodOffsetYears = 10000
+
+// periodsPerYear is the maximum number of periods of each unit in a year, used to bound offsets.
+var periodsPerYear = map[string]int{
+	"minute":  366 * 24 * 60,
+	"hour":    366 * 24,
+	"day":     366,
+	"week":    53,
+	"isoweek": 53,
+	"month":   12,
+	"quarter": 4,
+	"year":    1,
+}
+
+// GetPeriodUnits returns the units accepted by PeriodBounds.
+func GetPeriodUnits() []string { return periodUnits }
+
+// Period is a calendar period containing a time.
+type Period struct {
+	// Start is the first instant of the period.
+	Start string `json:"start"`
+	// End is the last instant of the period, one nanosecond before NextStart.
+	End string `json:"end"`
+	// NextStart is the first instant of the following period, which can be used as an excluded bound.
+	NextStart string `json:"next_start"`
+}
+
+// periodStart returns the start of the period of a wall clock, as returned by wallClock.
+// Weeks start on weekStart, and ISO weeks on Monday.
+func periodStart(wall time.Time, unit string, weekStart time.Weekday) time.Time {
+	year, month, day := wall.Date()
+
+	switch unit {
+	case "minute":
+		return wall.Truncate(time.Minute)
+	case "hour":
+		return wall.Truncate(time.Hour)
+	case "day":
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	case "week", "isoweek":
+		if unit == "isoweek" {
+			weekStart = time.Monday
+		}
+		back := (int(wall.Weekday()) - int(weekStart) + 7) % 7
+		return time.Date(year, month, day-back, 0, 0, 0, 0, time.UTC)
+	case "month":
+		return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	case "quarter":
+		return time.Date(year, month-(month-1)%3, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// shiftPeriod moves the start of a period, as a wall clock, by n periods.
+func shiftPeriod(wall time.Time, unit string, n int) time.Time {
+	switch unit {
+	case "minute":
+		// Minutes and hours are added in seconds, as a duration covers less than 300 years.
+		return time.Unix(wall.Unix()+int64(n)*60, int64(wall.Nanosecond())).UTC()
+	case "hour":
+		return time.Unix(wall.Unix()+int64(n)*3600, int64(wall.Nanosecond())).UTC()
+	case "day":
+		return wall.AddDate(0, 0, n)
+	case "week", "isoweek":
+		return wall.AddDate(0, 0, 7*n)
+	case "month":
+		return wall.AddDate(0, n, 0)
+	case "quarter":
+		return wall.AddDate(0, 3*n, 0)
+	default:
+		return wall.AddDate(n, 0, 0)
+	}
+}
+
+// periodInstant returns the instant at which the wall clock in location shows wall. When the wall clock is repeated
+// (e.g., when clocks are moved back), it returns the last occurrence at or before t if before is set, or the first
+// occurrence after t otherwise, falling back to fromWallClock.
+func periodInstant(wall time.Time, location *time.Location, t time.Time, before bool) time.Time {
+	instants := localInstants(wall, location)
+	if before {
+		for i := len(instants) - 1; i >= 0; i-- {
+			if !instants[i].After(t) {
+				return instants[i]
+			}
+		}
+	} else {
+		for _, instant := range instants {
+			if instant.After(t) {
+				return instant
+			}
+		}
+	}
+
+	return fromWallClock(wall, location)
+}
+
+// PeriodBounds returns the bounds of the calendar period (minute, hour, day, week, isoweek, month, quarter or year)
+// containing a time, evaluated on the wall clock of the given timezone. Input times without timezone are interpreted
+// in that timezone. The period is moved by offset periods (e.g., -1 for the previous one), up to
+// maxPeriodOffsetYears years.
+// Weeks start on weekStart, Monday by default, and ISO weeks always start on Monday.
+// Times are returned in the timezone and the specified format.
+func PeriodBounds(inputTime, unit string, offset int, timezone, weekStart string, inputFormat InputFormat, format Format) (*Period, error) {
+	unit = strings.ToLower(strings.TrimSpace(unit))
+	switch unit {
+	case "iso_week", "iso-week":
+		unit = "isoweek"
+	}
+	if !slices.Contains(periodUnits, unit) {
+		return nil, fmt.Errorf("invalid_unit: Invalid unit: %s, expected one of %s", unit, strings.Join(periodUnits, ", "))
+	}
+
+	if limit := maxPeriodOffsetYears * periodsPerYear[unit]; offset < -limit || offset > limit {
+		return nil, fmt.Errorf("invalid_offset: Offset must be between -%d and %d %ss, about %d years", limit, limit, unit, maxPeriodOffsetYears)
+	}
+
+	firstDay := defaultWeekStart
+	if weekStart != "" {
+		var err error
+		firstDay, err = parseWeekday(weekStart)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	location, err := ResolveTimezone(timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	dt, err := fromStringWithTimezone(inputTime, inputFormat, timezone, "time")
+	if err != nil {
+		return nil, err
+	}
+	t := dt.time.In(location)
+
+	startWall := shiftPeriod(periodStart(wallClock(t), unit, firstDay), unit, offset)
+	nextWall := shiftPeriod(startWall, unit, 1)
+
+	// Around repeated wall clocks, the period containing the time is the one surrounding it.
+	var start, next time.Time
+	if offset == 0 {
+		start = periodInstant(startWall, location, t, true)
+		next = periodInstant(nextWall, location, t, false)
+	} else {
+		start = fromWallClock(startWall, location)
+		next = fromWallClock(nextWall, location)
+	}
+
+	period := &Period{}
+	for _, b := range []struct {
+		t      time.Time
+		output *string
+	}{
+		{start, &period.Start},
+		{next.Add(-time.Nanosecond), &period.End},
+		{next, &period.NextStart},
+	} {
+		dt.time = b.t
+		*b.output, err = dt.format(format, timezone)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return period, nil
+}
+
+// StartOf returns the start of the calendar period containing a time, see PeriodBounds.
+func StartOf(inputTime, unit, timezone, weekStart string, inputFormat InputFormat, format Format) (string, error) {
+	period, err := PeriodBounds(inputTime, unit, 0, timezone, weekStart, inputFormat, format)
+	if err != nil {
+		return "", err
+	}
+
+	return period.Start, nil
+}
+
+// EndOf returns the last instant of the calendar period containing a time, see PeriodBounds.
+func EndOf(inputTime, unit, timezone, weekStart string, inputFormat InputFormat, format Format) (string, error) {
+	period, err := PeriodBounds(inputTime, unit, 0, timezone, weekStart, inputFormat, format)
+	if err != nil {
+		return "", err
+	}
+
+	return period.End, nil
+}
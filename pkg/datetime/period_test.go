@@ -0,0 +1,86 @@
+package datetime
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestPeriodBounds tests the PeriodBounds function.
+func TestPeriodBounds(t *testing.T) {
+	tests := []struct {
+		name              string
+		time              string
+		unit              string
+		offset            int
+		timezone          string
+		weekStart         string
+		expectedStart     string
+		expectedEnd       string
+		expectedNextStart string
+	}{
+		{"minute", "2025-07-09T15:04:05Z", "minute", 0, "", "", "2025-07-09T15:04:00Z", "2025-07-09T15:04:59.999999999Z", "2025-07-09T15:05:00Z"},
+		{"hour with half hour offset", "2025-07-09T15:04:05Z", "hour", 0, "Asia/Kolkata", "", "2025-07-09T20:00:00+05:30", "2025-07-09T20:59:59.999999999+05:30", "2025-07-09T21:00:00+05:30"},
+		{"day in timezone", "2025-07-09T23:30:00Z", "day", 0, "Europe/Berlin", "", "2025-07-10T00:00:00+02:00", "2025-07-10T23:59:59.999999999+02:00", "2025-07-11T00:00:00+02:00"},
+		{"week", "2025-07-09T12:00:00Z", "week", 0, "Europe/Berlin", "", "2025-07-07T00:00:00+02:00", "2025-07-13T23:59:59.999999999+02:00", "2025-07-14T00:00:00+02:00"},
+		{"week on sunday", "2025-07-09T12:00:00Z", "week", 0, "America/New_York", "Sunday", "2025-07-06T00:00:00-04:00", "2025-07-12T23:59:59.999999999-04:00", "2025-07-13T00:00:00-04:00"},
+		{"isoweek ignores week start", "2025-07-13T12:00:00Z", "isoweek", 0, "", "Sunday", "2025-07-07T00:00:00Z", "2025-07-13T23:59:59.999999999Z", "2025-07-14T00:00:00Z"},
+		{"month", "2025-02-14T12:00:00Z", "month", 0, "", "", "2025-02-01T00:00:00Z", "2025-02-28T23:59:59.999999999Z", "2025-03-01T00:00:00Z"},
+		{"last quarter", "2025-02-14T12:00:00Z", "quarter", -1, "Europe/Paris", "", "2024-10-01T00:00:00+02:00", "2024-12-31T23:59:59.999999999+01:00", "2025-01-01T00:00:00+01:00"},
+		{"next year", "2025-02-14T12:00:00Z", "year", 1, "", "", "2026-01-01T00:00:00Z", "2026-12-31T23:59:59.999999999Z", "2027-01-01T00:00:00Z"},
+		{"day with dst change", "2025-03-09T12:00:00", "day", 0, "America/New_York", "", "2025-03-09T00:00:00-05:00", "2025-03-09T23:59:59.999999999-04:00", "2025-03-10T00:00:00-04:00"},
+		{"day starting in a gap", "2025-09-07T12:00:00", "day", 0, "America/Santiago", "", "2025-09-07T01:00:00-03:00", "2025-09-07T23:59:59.999999999-03:00", "2025-09-08T00:00:00-03:00"},
+		{"minutes beyond a duration", "2025-07-09T15:04:05Z", "minute", 200000000, "", "", "2405-10-14T12:24:00Z", "2405-10-14T12:24:59.999999999Z", "2405-10-14T12:25:00Z"},
+		{"repeated hour", "2025-11-02T06:30:00Z", "hour", 0, "America/New_York", "", "2025-11-02T01:00:00-05:00", "2025-11-02T01:59:59.999999999-05:00", "2025-11-02T02:00:00-05:00"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			period, err := PeriodBounds(test.time, test.unit, test.offset, test.timezone, test.weekStart, InputFormat{}, Format{Layout: "2006-01-02T15:04:05.999999999Z07:00"})
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+
+			if period.Start != test.expectedStart || period.End != test.expectedEnd || period.NextStart != test.expectedNextStart {
+				t.Errorf("expected %s - %s - %s, got %s - %s - %s", test.expectedStart, test.expectedEnd, test.expectedNextStart, period.Start, period.End, period.NextStart)
+			}
+		})
+	}
+}
+
+// TestStartOfEndOf tests the StartOf and EndOf functions.
+func TestStartOfEndOf(t *testing.T) {
+	start, err := StartOf("2025-07-09 15:04", "week", "Europe/Berlin", "", InputFormat{}, Format{Layout: "RFC3339"})
+	if err != nil || start != "2025-07-07T00:00:00+02:00" {
+		t.Errorf("expected 2025-07-07T00:00:00+02:00, got %s (%v)", start, err)
+	}
+
+	end, err := EndOf("2025-07-09 15:04", "month", "Europe/Berlin", "", InputFormat{}, Format{Layout: "RFC3339"})
+	if err != nil || end != "2025-07-31T23:59:59+02:00" {
+		t.Errorf("expected 2025-07-31T23:59:59+02:00, got %s (%v)", end, err)
+	}
+}
+
+// TestPeriodBoundsInvalid tests that PeriodBounds rejects invalid arguments.
+func TestPeriodBoundsInvalid(t *testing.T) {
+	tests := []struct {
+		unit           string
+		offset         int
+		weekStart      string
+		expectedPrefix string
+	}{
+		{"fortnight", 0, "", "invalid_unit:"},
+		{"week", 0, "Caturday", "invalid_weekday:"},
+		{"year", 1 << 40, "", "invalid_offset:"},
+		{"minute", 1 << 62, "", "invalid_offset:"},
+		{"month", -maxPeriodOffsetYears*12 - 1, "", "invalid_offset:"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.unit, func(t *testing.T) {
+			_, err := PeriodBounds("", test.unit, test.offset, "", test.weekStart, InputFormat{}, Format{})
+			if err == nil || !strings.HasPrefix(err.Error(), test.expectedPrefix) {
+				t.Errorf("expected %s error, got %v", test.expectedPrefix, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package datetime
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/TheoBrigitte/mcp-time/pkg/places"
+)
+
+// maxPlaceCount is the maximum number of candidates returned by FindTimezone.
+const maxPlaceCount = 50
+
+// FindTimezone returns up to limit places (cities and countries) matching a place name, with their timezone,
+// the best match first. See places.Search for the query syntax and ranking.
+func FindTimezone(query string, limit int) ([]places.Candidate, error) {
+	if limit < 1 || limit > maxPlaceCount {
+		return nil, fmt.Errorf("invalid_limit: Limit must be between 1 and %d", maxPlaceCount)
+	}
+
+	candidates := places.Search(query, limit)
+	if len(candidates) == 0 && strings.Contains(query, ",") {
+		return nil, fmt.Errorf("unknown_location: No place found matching: %s (only countries are accepted after a comma, e.g., 'Portland, US')", query)
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("unknown_location: No place found matching: %s", query)
+	}
+
+	return candidates, nil
+}
+
+// LocationTimezone returns the timezone of the place best matching a place name (e.g., "Lagos", "Portland, US").
+func LocationTimezone(location string) (string, error) {
+	candidates, err := FindTimezone(location, 1)
+	if err != nil {
+		return "", err
+	}
+
+	return candidates[0].Timezone, nil
+}
@@ -0,0 +1,678 @@
+package datetime
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/TheoBrigitte/mcp-time/pkg/holidays"
+)
+
+// maxRecurrenceCount is the maximum number of occurrences returned by ExpandRecurrence.
+const maxRecurrenceCount = 1000
+
+// maxRecurrencePeriods is the maximum number of periods (e.g., days for a daily rule) examined when expanding a rule,
+// so that rules which never match do not loop forever.
+const maxRecurrencePeriods = 100000
+
+// recurrenceSearchYears is the number of years searched for occurrences, from the first period examined.
+const recurrenceSearchYears = 100
+
+// recurrenceSkipMargin is how far before the start of the window the expansion of a rule without COUNT resumes,
+// so that occurrences moved by a daylight saving time change are not skipped.
+const recurrenceSkipMargin = 2 * time.Hour
+
+// frequency is the FREQ of a recurrence rule, from the shortest to the longest.
+type frequency int
+
+const (
+	secondly frequency = iota
+	minutely
+	hourly
+	daily
+	weekly
+	monthly
+	yearly
+)
+
+// frequencies maps FREQ values to their frequency.
+var frequencies = map[string]frequency{
+	"SECONDLY": secondly,
+	"MINUTELY": minutely,
+	"HOURLY":   hourly,
+	"DAILY":    daily,
+	"WEEKLY":   weekly,
+	"MONTHLY":  monthly,
+	"YEARLY":   yearly,
+}
+
+// icalWeekdays maps iCalendar weekday names to their weekday.
+var icalWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// nthWeekday is a BYDAY value, such as "MO" (every Monday) or "-1FR" (the last Friday).
+type nthWeekday struct {
+	// n is the ordinal, zero for every weekday of the period.
+	n       int
+	weekday time.Weekday
+}
+
+// recurrenceRule is a parsed RFC 5545 recurrence rule (RRULE).
+type recurrenceRule struct {
+	freq     frequency
+	interval int
+	count    int
+	// until is the last allowed occurrence, as an instant.
+	until *time.Time
+	// untilWall is the last allowed occurrence, as a wall clock, when UNTIL has no timezone.
+	untilWall *time.Time
+
+	byMonth    []int
+	byMonthDay []int
+	byYearDay  []int
+	byDay      []nthWeekday
+	byHour     []int
+	byMinute   []int
+	bySecond   []int
+	bySetPos   []int
+	weekStart  time.Weekday
+}
+
+// parseRecurrenceRule parses an RRULE value (e.g., "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10"), with an optional "RRULE:" prefix.
+func parseRecurrenceRule(rule string) (*recurrenceRule, error) {
+	rule = strings.TrimSpace(rule)
+	if len(rule) >= 6 && strings.EqualFold(rule[:6], "RRULE:") {
+		rule = rule[6:]
+	}
+
+	r := &recurrenceRule{
+		freq:      -1,
+		interval:  1,
+		weekStart: time.Monday,
+	}
+
+	for _, part := range strings.Split(rule, ";") {
+		if part == "" {
+			continue
+		}
+
+		name, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return nil, fmt.Errorf("invalid rule part %q", part)
+		}
+		name = strings.ToUpper(name)
+		value = strings.ToUpper(value)
+
+		var err error
+		switch name {
+		case "FREQ":
+			f, ok := frequencies[value]
+			if !ok {
+				return nil, fmt.Errorf("invalid FREQ %q", value)
+			}
+			r.freq = f
+		case "INTERVAL":
+			r.interval, err = strconv.Atoi(value)
+			if err == nil && r.interval < 1 {
+				err = fmt.Errorf("must be positive")
+			}
+		case "COUNT":
+			r.count, err = strconv.Atoi(value)
+			if err == nil && r.count < 1 {
+				err = fmt.Errorf("must be positive")
+			}
+		case "UNTIL":
+			err = r.parseUntil(value)
+		case "BYMONTH":
+			r.byMonth, err = parseIntList(value, 1, 12, false)
+		case "BYMONTHDAY":
+			r.byMonthDay, err = parseIntList(value, 1, 31, true)
+		case "BYYEARDAY":
+			r.byYearDay, err = parseIntList(value, 1, 366, true)
+		case "BYHOUR":
+			r.byHour, err = parseIntList(value, 0, 23, false)
+		case "BYMINUTE":
+			r.byMinute, err = parseIntList(value, 0, 59, false)
+		case "BYSECOND":
+			r.bySecond, err = parseIntList(value, 0, 59, false)
+		case "BYSETPOS":
+			r.bySetPos, err = parseIntList(value, 1, 366, true)
+		case "BYDAY":
+			r.byDay, err = parseByDay(value)
+		case "WKST":
+			weekday, ok := icalWeekdays[value]
+			if !ok {
+				err = fmt.Errorf("invalid weekday")
+			}
+			r.weekStart = weekday
+		case "BYWEEKNO":
+			return nil, fmt.Errorf("BYWEEKNO is not supported")
+		default:
+			return nil, fmt.Errorf("unknown rule part %q", name)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %w", name, value, err)
+		}
+	}
+
+	if r.freq < 0 {
+		return nil, fmt.Errorf("missing FREQ")
+	}
+
+	if r.count > 0 && (r.until != nil || r.untilWall != nil) {
+		return nil, fmt.Errorf("COUNT and UNTIL cannot be used together")
+	}
+
+	for _, d := range r.byDay {
+		if d.n != 0 && r.freq != monthly && r.freq != yearly {
+			return nil, fmt.Errorf("BYDAY ordinals are only allowed with MONTHLY and YEARLY frequencies")
+		}
+	}
+
+	return r, nil
+}
+
+// parseRecurrence parses iCalendar recurrence lines: an RRULE line, and optional EXDATE lines
+// (e.g., "EXDATE:20250715T090000Z", "EXDATE;TZID=Europe/Paris:20250715T090000,20250722T090000").
+// A line without property name is an RRULE value. EXDATE values without timezone are interpreted in location.
+func parseRecurrence(text string, location *time.Location) (rule *recurrenceRule, exdates []time.Time, err error) {
+	for _, line := range strings.FieldsFunc(text, func(r rune) bool { return r == '\n' || r == '\r' }) {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		if len(line) < 6 || !strings.EqualFold(line[:6], "EXDATE") {
+			if rule != nil {
+				return nil, nil, fmt.Errorf("multiple RRULE lines")
+			}
+			rule, err = parseRecurrenceRule(line)
+			if err != nil {
+				return nil, nil, err
+			}
+			continue
+		}
+
+		params, values, ok := strings.Cut(line[6:], ":")
+		if !ok {
+			return nil, nil, fmt.Errorf("invalid EXDATE line %q", line)
+		}
+
+		loc := location
+		for _, param := range strings.Split(params, ";") {
+			name, value, _ := strings.Cut(param, "=")
+			if strings.EqualFold(name, "TZID") {
+				loc, err = ResolveTimezone(value)
+				if err != nil {
+					return nil, nil, fmt.Errorf("invalid EXDATE TZID: %w", err)
+				}
+			}
+		}
+
+		for _, value := range strings.Split(values, ",") {
+			exdate, err := parseICalendarTime(value, loc)
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid EXDATE value %q", value)
+			}
+			exdates = append(exdates, exdate)
+		}
+	}
+
+	if rule == nil {
+		return nil, nil, fmt.Errorf("missing RRULE")
+	}
+
+	return rule, exdates, nil
+}
+
+// parseICalendarTime parses an iCalendar DATE or DATE-TIME value (e.g., "20250715", "20250715T090000",
+// "20250715T090000Z"). Values without the UTC designator are interpreted in location.
+func parseICalendarTime(value string, location *time.Location) (time.Time, error) {
+	switch {
+	case len(value) == 8:
+		return time.ParseInLocation("20060102", value, location)
+	case strings.HasSuffix(value, "Z"):
+		return time.Parse("20060102T150405Z", value)
+	default:
+		return time.ParseInLocation("20060102T150405", value, location)
+	}
+}
+
+// parseUntil parses an UNTIL value, which is either a date (inclusive), a UTC date-time ending with "Z",
+// or a date-time in the timezone of the recurrence.
+func (r *recurrenceRule) parseUntil(value string) error {
+	// Values without timezone are parsed as wall clocks.
+	t, err := parseICalendarTime(value, time.UTC)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case len(value) == 8:
+		// A date includes the whole day.
+		t = t.AddDate(0, 0, 1).Add(-time.Nanosecond)
+		r.untilWall = &t
+	case strings.HasSuffix(value, "Z"):
+		r.until = &t
+	default:
+		r.untilWall = &t
+	}
+
+	return nil
+}
+
+// parseIntList parses a comma separated list of integers between min and max.
+// If negative is set, values between -max and -min are also allowed.
+func parseIntList(value string, min, max int, negative bool) ([]int, error) {
+	var values []int
+	for _, s := range strings.Split(value, ",") {
+		v, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, err
+		}
+
+		abs := v
+		if negative && v < 0 {
+			abs = -v
+		}
+		if abs < min || abs > max {
+			return nil, fmt.Errorf("value %d out of range", v)
+		}
+
+		values = append(values, v)
+	}
+
+	return values, nil
+}
+
+// parseByDay parses a BYDAY value, a comma separated list of weekdays with optional ordinals (e.g., "MO,-1FR,2TU").
+func parseByDay(value string) ([]nthWeekday, error) {
+	var days []nthWeekday
+	for _, s := range strings.Split(value, ",") {
+		if len(s) < 2 {
+			return nil, fmt.Errorf("invalid weekday %q", s)
+		}
+
+		weekday, ok := icalWeekdays[s[len(s)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("invalid weekday %q", s)
+		}
+
+		var n int
+		if ordinal := s[:len(s)-2]; ordinal != "" {
+			var err error
+			n, err = strconv.Atoi(ordinal)
+			if err != nil || n == 0 || n < -53 || n > 53 {
+				return nil, fmt.Errorf("invalid ordinal %q", ordinal)
+			}
+		}
+
+		days = append(days, nthWeekday{n: n, weekday: weekday})
+	}
+
+	return days, nil
+}
+
+// setDefaults fills in the rule parts implied by the start of the recurrence, as described by RFC 5545.
+func (r *recurrenceRule) setDefaults(start time.Time) {
+	if r.byDay == nil && r.byMonthDay == nil && r.byYearDay == nil {
+		switch r.freq {
+		case yearly:
+			if r.byMonth == nil {
+				r.byMonth = []int{int(start.Month())}
+			}
+			r.byMonthDay = []int{start.Day()}
+		case monthly:
+			r.byMonthDay = []int{start.Day()}
+		case weekly:
+			r.byDay = []nthWeekday{{weekday: start.Weekday()}}
+		}
+	}
+
+	if r.byHour == nil && r.freq > hourly {
+		r.byHour = []int{start.Hour()}
+	}
+	if r.byMinute == nil && r.freq > minutely {
+		r.byMinute = []int{start.Minute()}
+	}
+	if r.bySecond == nil && r.freq > secondly {
+		r.bySecond = []int{start.Second()}
+	}
+}
+
+// period returns the first day and the number of days of the period of index i, counted from the start of the
+// recurrence, and for sub-daily frequencies the wall clock of the period.
+func (r *recurrenceRule) period(start time.Time, i int) (first time.Time, days int, clock time.Time) {
+	year, month, day := start.Date()
+	step := i * r.interval
+
+	switch r.freq {
+	case yearly:
+		first = time.Date(year+step, time.January, 1, 0, 0, 0, 0, time.UTC)
+		return first, daysInYear(first.Year()), time.Time{}
+	case monthly:
+		first = time.Date(year, month+time.Month(step), 1, 0, 0, 0, 0, time.UTC)
+		return first, holidays.DaysIn(first.Year(), first.Month()), time.Time{}
+	case weekly:
+		offset := (int(start.Weekday()) - int(r.weekStart) + 7) % 7
+		first = time.Date(year, month, day-offset+7*step, 0, 0, 0, 0, time.UTC)
+		return first, 7, time.Time{}
+	case daily:
+		return time.Date(year, month, day+step, 0, 0, 0, 0, time.UTC), 1, time.Time{}
+	}
+
+	// Sub-daily frequencies step on the wall clock.
+	unit := map[frequency]time.Duration{hourly: time.Hour, minutely: time.Minute, secondly: time.Second}[r.freq]
+	clock = start.Truncate(unit).Add(time.Duration(step) * unit)
+	year, month, day = clock.Date()
+
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC), 1, clock
+}
+
+// matchDay reports whether the day matches the BYMONTH, BYYEARDAY, BYMONTHDAY and BYDAY rule parts.
+func (r *recurrenceRule) matchDay(day time.Time) bool {
+	if r.byMonth != nil && !slices.Contains(r.byMonth, int(day.Month())) {
+		return false
+	}
+
+	if r.byYearDay != nil {
+		length := daysInYear(day.Year())
+		if !slices.Contains(r.byYearDay, day.YearDay()) && !slices.Contains(r.byYearDay, day.YearDay()-length-1) {
+			return false
+		}
+	}
+
+	if r.byMonthDay != nil {
+		length := holidays.DaysIn(day.Year(), day.Month())
+		if !slices.Contains(r.byMonthDay, day.Day()) && !slices.Contains(r.byMonthDay, day.Day()-length-1) {
+			return false
+		}
+	}
+
+	if r.byDay != nil {
+		// Ordinals count within the month for monthly rules, and yearly rules restricted by month.
+		index, length := day.YearDay(), daysInYear(day.Year())
+		if r.freq == monthly || (r.freq == yearly && r.byMonth != nil) {
+			index, length = day.Day(), holidays.DaysIn(day.Year(), day.Month())
+		}
+
+		match := false
+		for _, d := range r.byDay {
+			if d.weekday != day.Weekday() {
+				continue
+			}
+			if d.n == 0 || d.n == (index-1)/7+1 || d.n == -((length-index)/7+1) {
+				match = true
+				break
+			}
+		}
+		if !match {
+			return false
+		}
+	}
+
+	return true
+}
+
+// candidates returns the sorted wall clocks of the occurrences in the period of index i, with BYSETPOS applied.
+func (r *recurrenceRule) candidates(start time.Time, i int) []time.Time {
+	first, days, clock := r.period(start, i)
+
+	var result []time.Time
+	for d := 0; d < days; d++ {
+		day := first.AddDate(0, 0, d)
+		if !r.matchDay(day) {
+			continue
+		}
+
+		// Sub-daily periods only contain their own hour, minute or second.
+		hours, minutes, seconds := r.byHour, r.byMinute, r.bySecond
+		if r.freq <= hourly {
+			if hours != nil && !slices.Contains(hours, clock.Hour()) {
+				continue
+			}
+			hours = []int{clock.Hour()}
+		}
+		if r.freq <= minutely {
+			if minutes != nil && !slices.Contains(minutes, clock.Minute()) {
+				continue
+			}
+			minutes = []int{clock.Minute()}
+		}
+		if r.freq == secondly {
+			if seconds != nil && !slices.Contains(seconds, clock.Second()) {
+				continue
+			}
+			seconds = []int{clock.Second()}
+		}
+
+		for _, hour := range hours {
+			for _, minute := range minutes {
+				for _, second := range seconds {
+					result = append(result, time.Date(day.Year(), day.Month(), day.Day(), hour, minute, second, 0, time.UTC))
+				}
+			}
+		}
+	}
+
+	slices.SortFunc(result, func(a, b time.Time) int { return a.Compare(b) })
+	result = slices.CompactFunc(result, func(a, b time.Time) bool { return a.Equal(b) })
+
+	if r.bySetPos == nil {
+		return result
+	}
+
+	var selected []time.Time
+	for _, position := range r.bySetPos {
+		index := position - 1
+		if position < 0 {
+			index = len(result) + position
+		}
+		if index >= 0 && index < len(result) {
+			selected = append(selected, result[index])
+		}
+	}
+	slices.SortFunc(selected, func(a, b time.Time) int { return a.Compare(b) })
+
+	return slices.CompactFunc(selected, func(a, b time.Time) bool { return a.Equal(b) })
+}
+
+// periodIndex returns the index of the period containing the wall clock, counted from the start of the recurrence,
+// or zero if it is before the start.
+func (r *recurrenceRule) periodIndex(start, wall time.Time) int {
+	var units int64
+	switch r.freq {
+	case yearly:
+		units = int64(wall.Year() - start.Year())
+	case monthly:
+		units = int64(wall.Year()-start.Year())*12 + int64(wall.Month()-start.Month())
+	case weekly:
+		offset := int64(int(start.Weekday())-int(r.weekStart)+7) % 7
+		units = (unixDays(wall) - unixDays(start) + offset) / 7
+	case daily:
+		units = unixDays(wall) - unixDays(start)
+	default:
+		unit := map[frequency]time.Duration{hourly: time.Hour, minutely: time.Minute, secondly: time.Second}[r.freq]
+		units = (wall.Unix() - start.Truncate(unit).Unix()) / int64(unit/time.Second)
+	}
+
+	if units <= 0 {
+		return 0
+	}
+
+	return int(units / int64(r.interval))
+}
+
+// unixDays returns the number of days between the Unix epoch and the date of the wall clock.
+func unixDays(wall time.Time) int64 {
+	year, month, day := wall.Date()
+
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix() / 86400
+}
+
+// expand returns the occurrences of the rule starting at start, within [windowStart, windowEnd], up to limit occurrences.
+// A zero windowEnd means no end. Occurrences listed in exclude are skipped, but count toward COUNT.
+// Wall clocks falling in a daylight saving time gap are interpreted using the offset before the gap, as per RFC 5545.
+// Without COUNT, the expansion starts at the period containing windowStart. An error is returned when the search
+// is stopped after maxRecurrencePeriods periods.
+func (r *recurrenceRule) expand(start time.Time, location *time.Location, windowStart, windowEnd time.Time, exclude []time.Time, limit int) ([]time.Time, error) {
+	startWall := wallClock(start.In(location))
+	r.setDefaults(startWall)
+
+	// Occurrences are counted from the start of the recurrence, which is only needed with COUNT.
+	first := 0
+	if r.count == 0 && windowStart.After(start) {
+		first = r.periodIndex(startWall, wallClock(windowStart.In(location)).Add(-recurrenceSkipMargin))
+	}
+	firstPeriod, _, _ := r.period(startWall, first)
+	lastYear := firstPeriod.Year() + recurrenceSearchYears
+
+	var result []time.Time
+	count := 0
+	for i := first; i < first+maxRecurrencePeriods; i++ {
+		if period, _, _ := r.period(startWall, i); period.Year() > lastYear {
+			return result, nil
+		}
+
+		for _, wall := range r.candidates(startWall, i) {
+			if wall.Before(startWall) {
+				continue
+			}
+
+			if r.untilWall != nil && wall.After(*r.untilWall) {
+				return result, nil
+			}
+
+			instant := recurrenceInstant(wall, location)
+			if r.until != nil && instant.After(*r.until) {
+				return result, nil
+			}
+			if !windowEnd.IsZero() && instant.After(windowEnd) {
+				return result, nil
+			}
+
+			count++
+			if !instant.Before(windowStart) && !slices.ContainsFunc(exclude, instant.Equal) {
+				result = append(result, instant)
+				if len(result) >= limit {
+					return result, nil
+				}
+			}
+
+			if r.count > 0 && count >= r.count {
+				return result, nil
+			}
+		}
+	}
+
+	last, _, _ := r.period(startWall, first+maxRecurrencePeriods)
+	if r.count > 0 {
+		return nil, fmt.Errorf("search_limit: The rule was expanded over %d periods up to %s without reaching the end of the window; with COUNT, occurrences are counted from dtstart, use a dtstart closer to the window",
+			maxRecurrencePeriods, last.Format(time.DateOnly))
+	}
+
+	return nil, fmt.Errorf("search_limit: The rule was expanded over %d periods up to %s without reaching the end of the window; use a narrower window or a rule matching more often",
+		maxRecurrencePeriods, last.Format(time.DateOnly))
+}
+
+// recurrenceInstant returns the instant of a recurrence wall clock in location.
+// A repeated wall clock resolves to its first occurrence, and a wall clock falling in a gap is interpreted
+// using the offset before the gap (e.g., 02:30 becomes 03:30 when clocks move forward from 02:00 to 03:00).
+func recurrenceInstant(wall time.Time, location *time.Location) time.Time {
+	instants := localInstants(wall, location)
+	if len(instants) > 0 {
+		return instants[0]
+	}
+
+	_, offset := wall.AddDate(0, 0, -1).In(location).Zone()
+
+	return wall.Add(-time.Duration(offset) * time.Second).In(location)
+}
+
+// daysInYear returns the number of days in the given year.
+func daysInYear(year int) int {
+	return time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
+}
+
+// ExpandRecurrence expands an RFC 5545 recurrence rule (RRULE) starting at dtstart, and returns its occurrences
+// within a window, in the specified timezone and format.
+//
+// The rule is an RRULE value (e.g., "FREQ=WEEKLY;BYDAY=MO,WE"), optionally followed by iCalendar EXDATE lines.
+// It is evaluated on the wall clock of the given timezone, which is also used for input times without timezone.
+// The window starts at windowStart (defaults to dtstart) and ends at windowEnd (defaults to no end).
+// Occurrences matching one of the exdates are excluded. At most limit occurrences are returned.
+func ExpandRecurrence(dtstart, rule string, exdates []string, timezone, windowStart, windowEnd string, limit int, inputFormat InputFormat, format Format) ([]string, error) {
+	if limit < 1 || limit > maxRecurrenceCount {
+		return nil, fmt.Errorf("invalid_limit: Limit must be between 1 and %d", maxRecurrenceCount)
+	}
+
+	start, err := fromStringWithTimezone(dtstart, inputFormat, timezone, "dtstart")
+	if err != nil {
+		return nil, err
+	}
+
+	var location = defaultLocation
+	if timezone != "" {
+		location, err = ResolveTimezone(timezone)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	from := start.time
+	if windowStart != "" {
+		w, err := fromStringWithTimezone(windowStart, inputFormat, timezone, "window_start")
+		if err != nil {
+			return nil, err
+		}
+		from = w.time
+	}
+
+	var to time.Time
+	if windowEnd != "" {
+		w, err := fromStringWithTimezone(windowEnd, inputFormat, timezone, "window_end")
+		if err != nil {
+			return nil, err
+		}
+		to = w.time
+	}
+
+	r, exclude, err := parseRecurrence(rule, location)
+	if err != nil {
+		return nil, fmt.Errorf("invalid_rrule: Invalid recurrence rule %q: %s", rule, err)
+	}
+
+	for _, exdate := range exdates {
+		e, err := fromStringWithTimezone(exdate, inputFormat, timezone, "exdate")
+		if err != nil {
+			return nil, err
+		}
+		exclude = append(exclude, e.time)
+	}
+
+	instants, err := r.expand(start.time, location, from, to, exclude, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]string, 0, len(instants))
+	for _, instant := range instants {
+		o, err := fromTime(instant).format(format, timezone)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, o)
+	}
+
+	return output, nil
+}
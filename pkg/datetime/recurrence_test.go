@@ -0,0 +1,298 @@
+package datetime
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestParseRecurrenceRuleInvalid tests that parseRecurrenceRule rejects invalid rules.
+func TestParseRecurrenceRuleInvalid(t *testing.T) {
+	tests := []string{
+		"",
+		"INTERVAL=2",
+		"FREQ=FOO",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=DAILY;COUNT=0",
+		"FREQ=DAILY;COUNT=2;UNTIL=20250101",
+		"FREQ=DAILY;UNTIL=2025-01-01",
+		"FREQ=DAILY;BYMONTH=13",
+		"FREQ=DAILY;BYMONTHDAY=0",
+		"FREQ=DAILY;BYHOUR=24",
+		"FREQ=WEEKLY;BYDAY=1MO",
+		"FREQ=MONTHLY;BYDAY=XX",
+		"FREQ=YEARLY;BYWEEKNO=1",
+		"FREQ=DAILY;FOO=1",
+		"FREQ=DAILY;COUNT",
+	}
+
+	for _, test := range tests {
+		t.Run(test, func(t *testing.T) {
+			_, err := parseRecurrenceRule(test)
+			if err == nil {
+				t.Errorf("expected error for %q", test)
+			}
+		})
+	}
+}
+
+// TestExpandRecurrence tests the ExpandRecurrence function.
+func TestExpandRecurrence(t *testing.T) {
+	tests := []struct {
+		name           string
+		dtstart        string
+		rule           string
+		exdates        []string
+		timezone       string
+		windowStart    string
+		windowEnd      string
+		expectedOutput []string
+	}{
+		{
+			"daily count",
+			"2025-07-08T09:00:00",
+			"RRULE:FREQ=DAILY;COUNT=3",
+			nil,
+			"Europe/Paris",
+			"",
+			"",
+			[]string{"2025-07-08T09:00:00+02:00", "2025-07-09T09:00:00+02:00", "2025-07-10T09:00:00+02:00"},
+		},
+		{
+			"weekly by day",
+			"2025-07-08T09:00:00Z",
+			"FREQ=WEEKLY;BYDAY=MO,WE;COUNT=4",
+			nil,
+			"",
+			"",
+			"",
+			[]string{"2025-07-09T09:00:00Z", "2025-07-14T09:00:00Z", "2025-07-16T09:00:00Z", "2025-07-21T09:00:00Z"},
+		},
+		{
+			"weekly interval",
+			"2025-07-08T09:00:00Z",
+			"FREQ=WEEKLY;INTERVAL=2;COUNT=3",
+			nil,
+			"",
+			"",
+			"",
+			[]string{"2025-07-08T09:00:00Z", "2025-07-22T09:00:00Z", "2025-08-05T09:00:00Z"},
+		},
+		{
+			"monthly last friday",
+			"2025-07-01T18:00:00Z",
+			"FREQ=MONTHLY;BYDAY=-1FR;COUNT=3",
+			nil,
+			"",
+			"",
+			"",
+			[]string{"2025-07-25T18:00:00Z", "2025-08-29T18:00:00Z", "2025-09-26T18:00:00Z"},
+		},
+		{
+			"monthly skips short months",
+			"2025-01-31T00:00:00Z",
+			"FREQ=MONTHLY;COUNT=3",
+			nil,
+			"",
+			"",
+			"",
+			[]string{"2025-01-31T00:00:00Z", "2025-03-31T00:00:00Z", "2025-05-31T00:00:00Z"},
+		},
+		{
+			"monthly last day",
+			"2025-01-15T00:00:00Z",
+			"FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=3",
+			nil,
+			"",
+			"",
+			"",
+			[]string{"2025-01-31T00:00:00Z", "2025-02-28T00:00:00Z", "2025-03-31T00:00:00Z"},
+		},
+		{
+			"last weekday of the month",
+			"2025-05-01T17:00:00Z",
+			"FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1;COUNT=3",
+			nil,
+			"",
+			"",
+			"",
+			[]string{"2025-05-30T17:00:00Z", "2025-06-30T17:00:00Z", "2025-07-31T17:00:00Z"},
+		},
+		{
+			"yearly leap day",
+			"2024-02-29T12:00:00Z",
+			"FREQ=YEARLY;COUNT=2",
+			nil,
+			"",
+			"",
+			"",
+			[]string{"2024-02-29T12:00:00Z", "2028-02-29T12:00:00Z"},
+		},
+		{
+			"yearly nth weekday of month",
+			"2025-01-01T00:00:00",
+			"FREQ=YEARLY;BYMONTH=11;BYDAY=4TH;COUNT=2",
+			nil,
+			"America/New_York",
+			"",
+			"",
+			[]string{"2025-11-27T00:00:00-05:00", "2026-11-26T00:00:00-05:00"},
+		},
+		{
+			"hourly interval",
+			"2025-07-08T09:15:00Z",
+			"FREQ=HOURLY;INTERVAL=4;COUNT=3",
+			nil,
+			"",
+			"",
+			"",
+			[]string{"2025-07-08T09:15:00Z", "2025-07-08T13:15:00Z", "2025-07-08T17:15:00Z"},
+		},
+		{
+			"until date is inclusive",
+			"2025-07-08T09:00:00Z",
+			"FREQ=DAILY;UNTIL=20250710",
+			nil,
+			"",
+			"",
+			"",
+			[]string{"2025-07-08T09:00:00Z", "2025-07-09T09:00:00Z", "2025-07-10T09:00:00Z"},
+		},
+		{
+			"until UTC",
+			"2025-07-08T09:00:00",
+			"FREQ=DAILY;UNTIL=20250709T070000Z",
+			nil,
+			"Europe/Paris",
+			"",
+			"",
+			[]string{"2025-07-08T09:00:00+02:00", "2025-07-09T09:00:00+02:00"},
+		},
+		{
+			"exdates count toward COUNT",
+			"2025-07-08T09:00:00Z",
+			"FREQ=DAILY;COUNT=3",
+			[]string{"2025-07-09T09:00:00Z"},
+			"",
+			"",
+			"",
+			[]string{"2025-07-08T09:00:00Z", "2025-07-10T09:00:00Z"},
+		},
+		{
+			"EXDATE lines",
+			"2025-07-08T09:00:00",
+			"RRULE:FREQ=DAILY;COUNT=4\nEXDATE;TZID=Europe/Paris:20250709T090000\nEXDATE:20250710T070000Z",
+			nil,
+			"Europe/Paris",
+			"",
+			"",
+			[]string{"2025-07-08T09:00:00+02:00", "2025-07-11T09:00:00+02:00"},
+		},
+		{
+			"window",
+			"2025-07-08T09:00:00Z",
+			"FREQ=DAILY",
+			nil,
+			"",
+			"2025-07-20T00:00:00Z",
+			"2025-07-22T23:00:00Z",
+			[]string{"2025-07-20T09:00:00Z", "2025-07-21T09:00:00Z", "2025-07-22T09:00:00Z"},
+		},
+		{
+			"DST gap uses the offset before the gap",
+			"2025-03-29T02:30:00",
+			"FREQ=DAILY;COUNT=3",
+			nil,
+			"Europe/Paris",
+			"",
+			"",
+			[]string{"2025-03-29T02:30:00+01:00", "2025-03-30T03:30:00+02:00", "2025-03-31T02:30:00+02:00"},
+		},
+		{
+			"DST overlap uses the first occurrence",
+			"2025-10-25T02:30:00",
+			"FREQ=DAILY;COUNT=2",
+			nil,
+			"Europe/Paris",
+			"",
+			"",
+			[]string{"2025-10-25T02:30:00+02:00", "2025-10-26T02:30:00+02:00"},
+		},
+		{
+			"never matching",
+			"2025-01-01T00:00:00Z",
+			"FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30",
+			nil,
+			"",
+			"",
+			"",
+			[]string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			output, err := ExpandRecurrence(test.dtstart, test.rule, test.exdates, test.timezone, test.windowStart, test.windowEnd, 100, InputFormat{}, Format{Layout: "RFC3339"})
+			if err != nil {
+				t.Errorf("unexpected error %v", err)
+				return
+			}
+
+			if strings.Join(output, ", ") != strings.Join(test.expectedOutput, ", ") {
+				t.Errorf("expected output %q, got %q", test.expectedOutput, output)
+			}
+		})
+	}
+}
+
+// TestExpandRecurrenceLimit tests that ExpandRecurrence stops after limit occurrences of unbounded rules.
+func TestExpandRecurrenceLimit(t *testing.T) {
+	output, err := ExpandRecurrence("2025-07-08T09:00:00Z", "FREQ=MINUTELY", nil, "", "", "", 5, InputFormat{}, Format{})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if len(output) != 5 {
+		t.Errorf("expected 5 occurrences, got %d", len(output))
+	}
+
+	_, err = ExpandRecurrence("2025-07-08T09:00:00Z", "FREQ=DAILY", nil, "", "", "", maxRecurrenceCount+1, InputFormat{}, Format{})
+	if err == nil || !strings.HasPrefix(err.Error(), "invalid_limit:") {
+		t.Errorf("expected invalid_limit error, got %v", err)
+	}
+}
+
+// TestExpandRecurrenceFarWindow tests windows far from the start of the recurrence.
+func TestExpandRecurrenceFarWindow(t *testing.T) {
+	tests := []struct {
+		dtstart        string
+		rule           string
+		timezone       string
+		windowStart    string
+		expectedOutput []string
+	}{
+		{"2010-01-01T00:30:00Z", "FREQ=HOURLY", "", "2025-07-08T10:00:00Z", []string{"2025-07-08T10:30:00Z", "2025-07-08T11:30:00Z"}},
+		{"2025-01-01T00:00:00Z", "FREQ=MINUTELY;INTERVAL=7", "", "2025-06-01T00:00:00Z", []string{"2025-06-01T00:01:00Z", "2025-06-01T00:08:00Z"}},
+		{"2010-01-04T09:00:00", "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO", "Europe/Paris", "2025-07-08T00:00:00Z", []string{"2025-07-14T09:00:00+02:00", "2025-07-28T09:00:00+02:00"}},
+		{"2010-03-27T02:30:00", "FREQ=DAILY", "Europe/Paris", "2025-03-30T01:00:00Z", []string{"2025-03-30T03:30:00+02:00", "2025-03-31T02:30:00+02:00"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.rule, func(t *testing.T) {
+			output, err := ExpandRecurrence(test.dtstart, test.rule, nil, test.timezone, test.windowStart, "", 2, InputFormat{}, Format{Layout: "RFC3339"})
+			if err != nil {
+				t.Errorf("unexpected error %v", err)
+				return
+			}
+
+			if strings.Join(output, ", ") != strings.Join(test.expectedOutput, ", ") {
+				t.Errorf("expected output %q, got %q", test.expectedOutput, output)
+			}
+		})
+	}
+
+	// With COUNT, occurrences are counted from dtstart.
+	_, err := ExpandRecurrence("2025-01-01T00:00:00Z", "FREQ=MINUTELY;COUNT=1000000", nil, "", "2025-06-01T00:00:00Z", "", 2, InputFormat{}, Format{})
+	if err == nil || !strings.HasPrefix(err.Error(), "search_limit:") {
+		t.Errorf("expected search_limit error, got %v", err)
+	}
+}
@@ -0,0 +1,153 @@
+package datetime
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// timezoneAbbreviation is a meaning of a timezone abbreviation.
+type timezoneAbbreviation struct {
+	// description is the full name of the zone (e.g., "Eastern Standard Time").
+	description string
+	// offset is the UTC offset of the zone, in seconds east of UTC.
+	offset int
+	// example is an IANA timezone using the zone.
+	example string
+}
+
+// timezoneAbbreviations maps common timezone abbreviations to their meanings.
+// Abbreviations with several meanings are ambiguous, and abbreviations which are also IANA names
+// (e.g., "EST", "MST", "HST") are resolved as such.
+var timezoneAbbreviations = map[string][]timezoneAbbreviation{
+	// North America.
+	"PST":  {{"Pacific Standard Time", -8 * 3600, "America/Los_Angeles"}},
+	"PDT":  {{"Pacific Daylight Time", -7 * 3600, "America/Los_Angeles"}},
+	"MDT":  {{"Mountain Daylight Time", -6 * 3600, "America/Denver"}},
+	"EDT":  {{"Eastern Daylight Time", -4 * 3600, "America/New_York"}},
+	"AKST": {{"Alaska Standard Time", -9 * 3600, "America/Anchorage"}},
+	"AKDT": {{"Alaska Daylight Time", -8 * 3600, "America/Anchorage"}},
+	"NST":  {{"Newfoundland Standard Time", -(3*3600 + 1800), "America/St_Johns"}},
+	"NDT":  {{"Newfoundland Daylight Time", -(2*3600 + 1800), "America/St_Johns"}},
+	"ADT":  {{"Atlantic Daylight Time", -3 * 3600, "America/Halifax"}},
+	"CST": {
+		{"Central Standard Time", -6 * 3600, "America/Chicago"},
+		{"China Standard Time", 8 * 3600, "Asia/Shanghai"},
+		{"Cuba Standard Time", -5 * 3600, "America/Havana"},
+	},
+	"CDT": {
+		{"Central Daylight Time", -5 * 3600, "America/Chicago"},
+		{"Cuba Daylight Time", -4 * 3600, "America/Havana"},
+	},
+	"AST": {
+		{"Atlantic Standard Time", -4 * 3600, "America/Halifax"},
+		{"Arabia Standard Time", 3 * 3600, "Asia/Riyadh"},
+	},
+
+	// South America.
+	"BRT": {{"Brasília Time", -3 * 3600, "America/Sao_Paulo"}},
+	"ART": {{"Argentina Time", -3 * 3600, "America/Argentina/Buenos_Aires"}},
+
+	// Europe and Africa.
+	"WEST": {{"Western European Summer Time", 1 * 3600, "Europe/Lisbon"}},
+	"CEST": {{"Central European Summer Time", 2 * 3600, "Europe/Paris"}},
+	"EEST": {{"Eastern European Summer Time", 3 * 3600, "Europe/Athens"}},
+	"MSK":  {{"Moscow Standard Time", 3 * 3600, "Europe/Moscow"}},
+	"WAT":  {{"West Africa Time", 1 * 3600, "Africa/Lagos"}},
+	"CAT":  {{"Central Africa Time", 2 * 3600, "Africa/Maputo"}},
+	"EAT":  {{"East Africa Time", 3 * 3600, "Africa/Nairobi"}},
+	"SAST": {{"South Africa Standard Time", 2 * 3600, "Africa/Johannesburg"}},
+	"BST": {
+		{"British Summer Time", 1 * 3600, "Europe/London"},
+		{"Bangladesh Standard Time", 6 * 3600, "Asia/Dhaka"},
+	},
+	"IST": {
+		{"India Standard Time", 5*3600 + 1800, "Asia/Kolkata"},
+		{"Irish Standard Time", 1 * 3600, "Europe/Dublin"},
+		{"Israel Standard Time", 2 * 3600, "Asia/Jerusalem"},
+	},
+
+	// Asia and Oceania.
+	"GST": {
+		{"Gulf Standard Time", 4 * 3600, "Asia/Dubai"},
+		{"South Georgia Time", -2 * 3600, "Atlantic/South_Georgia"},
+	},
+	"PKT":  {{"Pakistan Standard Time", 5 * 3600, "Asia/Karachi"}},
+	"NPT":  {{"Nepal Time", 5*3600 + 2700, "Asia/Kathmandu"}},
+	"ICT":  {{"Indochina Time", 7 * 3600, "Asia/Bangkok"}},
+	"WIB":  {{"Western Indonesia Time", 7 * 3600, "Asia/Jakarta"}},
+	"HKT":  {{"Hong Kong Time", 8 * 3600, "Asia/Hong_Kong"}},
+	"PHT":  {{"Philippine Time", 8 * 3600, "Asia/Manila"}},
+	"SGT":  {{"Singapore Time", 8 * 3600, "Asia/Singapore"}},
+	"AWST": {{"Australian Western Standard Time", 8 * 3600, "Australia/Perth"}},
+	"JST":  {{"Japan Standard Time", 9 * 3600, "Asia/Tokyo"}},
+	"KST":  {{"Korea Standard Time", 9 * 3600, "Asia/Seoul"}},
+	"ACST": {{"Australian Central Standard Time", 9*3600 + 1800, "Australia/Adelaide"}},
+	"ACDT": {{"Australian Central Daylight Time", 10*3600 + 1800, "Australia/Adelaide"}},
+	"AEST": {{"Australian Eastern Standard Time", 10 * 3600, "Australia/Sydney"}},
+	"AEDT": {{"Australian Eastern Daylight Time", 11 * 3600, "Australia/Sydney"}},
+	"NZST": {{"New Zealand Standard Time", 12 * 3600, "Pacific/Auckland"}},
+	"NZDT": {{"New Zealand Daylight Time", 13 * 3600, "Pacific/Auckland"}},
+	"SST": {
+		{"Samoa Standard Time", -11 * 3600, "Pacific/Pago_Pago"},
+		{"Singapore Standard Time", 8 * 3600, "Asia/Singapore"},
+	},
+}
+
+// ResolveTimezone resolves a timezone name into a location. It accepts:
+//   - IANA timezone names (e.g., "Europe/Paris"), case insensitive.
+//   - UTC offsets, optionally prefixed by "UTC" or "GMT" (e.g., "+05:30", "UTC-3"), as fixed zones.
+//   - Common abbreviations (e.g., "PST", "CEST"), as fixed zones. Abbreviations with several meanings,
+//     such as "CST" or "IST", are rejected with an ambiguous_timezone error listing the candidates.
+//   - Geographic coordinates, as a latitude and a longitude in decimal degrees (e.g., "48.8566,2.3522"),
+//     resolved to the timezone containing them.
+//
+// An empty name resolves to the default timezone. "Local" is rejected, as it would expose the timezone of the server.
+func ResolveTimezone(timezone string) (*time.Location, error) {
+	name := strings.TrimSpace(timezone)
+	if name == "" {
+		return defaultLocation, nil
+	}
+
+	if strings.EqualFold(name, "Local") {
+		return nil, fmt.Errorf("invalid_timezone: Invalid timezone name: %s; the local timezone of the server is not available, use an IANA timezone name instead", timezone)
+	}
+
+	if location, err := time.LoadLocation(name); err == nil {
+		return location, nil
+	}
+
+	for _, n := range timezoneNames {
+		if strings.EqualFold(n, name) {
+			return time.LoadLocation(n)
+		}
+	}
+
+	if latitude, longitude, ok := parseCoordinates(name); ok {
+		timezones, err := coordinatesTimezones(latitude, longitude)
+		if err != nil {
+			return nil, err
+		}
+		return time.LoadLocation(timezones[0])
+	}
+
+	if offset, ok := parseOffset(name); ok {
+		return time.FixedZone("UTC"+formatOffset(offset), offset), nil
+	}
+
+	abbreviation := strings.ToUpper(name)
+	switch candidates := timezoneAbbreviations[abbreviation]; len(candidates) {
+	case 0:
+	case 1:
+		return time.FixedZone(abbreviation, candidates[0].offset), nil
+	default:
+		descriptions := make([]string, 0, len(candidates))
+		for _, c := range candidates {
+			descriptions = append(descriptions, fmt.Sprintf("%s (UTC%s, e.g. %s)", c.description, formatOffset(c.offset), c.example))
+		}
+
+		return nil, fmt.Errorf("ambiguous_timezone: Ambiguous timezone abbreviation: %s could be %s; use an IANA timezone name or a UTC offset instead", timezone, strings.Join(descriptions, ", "))
+	}
+
+	return nil, fmt.Errorf("invalid_timezone: Invalid timezone name: %s%s", timezone, didYouMean(timezone))
+}
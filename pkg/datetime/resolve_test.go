@@ -0,0 +1,83 @@
+package datetime
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestResolveTimezone tests the ResolveTimezone function.
+func TestResolveTimezone(t *testing.T) {
+	reference := time.Date(2025, time.July, 8, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		timezone       string
+		expectedName   string
+		expectedOffset int
+	}{
+		{"", "UTC", 0},
+		{"Europe/Paris", "Europe/Paris", 2 * 3600},
+		{"europe/paris", "Europe/Paris", 2 * 3600},
+		{"America/new_york", "America/New_York", -4 * 3600},
+		{"EST", "EST", -5 * 3600},
+		{"+05:30", "UTC+05:30", 5*3600 + 1800},
+		{"UTC-3", "UTC-03:00", -3 * 3600},
+		{"GMT+0545", "UTC+05:45", 5*3600 + 2700},
+		{"PST", "PST", -8 * 3600},
+		{"cest", "CEST", 2 * 3600},
+		{"JST", "JST", 9 * 3600},
+	}
+
+	for _, test := range tests {
+		t.Run(test.timezone, func(t *testing.T) {
+			location, err := ResolveTimezone(test.timezone)
+			if err != nil {
+				t.Errorf("unexpected error %v", err)
+				return
+			}
+
+			_, offset := reference.In(location).Zone()
+			if location.String() != test.expectedName || offset != test.expectedOffset {
+				t.Errorf("expected %s at %d, got %s at %d", test.expectedName, test.expectedOffset, location.String(), offset)
+			}
+		})
+	}
+}
+
+// TestResolveTimezoneInvalid tests that ResolveTimezone rejects invalid and ambiguous timezones.
+func TestResolveTimezoneInvalid(t *testing.T) {
+	tests := []struct {
+		timezone       string
+		expectedPrefix string
+		expectedText   string
+	}{
+		{"CST", "ambiguous_timezone:", "America/Chicago"},
+		{"ist", "ambiguous_timezone:", "Asia/Kolkata"},
+		{"Europe/Pari", "invalid_timezone:", `did you mean "Europe/Paris"`},
+		{"+15:00", "invalid_timezone:", ""},
+		{"XYZT", "invalid_timezone:", ""},
+		{"Local", "invalid_timezone:", "not available"},
+		{" local ", "invalid_timezone:", "not available"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.timezone, func(t *testing.T) {
+			_, err := ResolveTimezone(test.timezone)
+			if err == nil || !strings.HasPrefix(err.Error(), test.expectedPrefix) || !strings.Contains(err.Error(), test.expectedText) {
+				t.Errorf("expected %s error containing %q, got %v", test.expectedPrefix, test.expectedText, err)
+			}
+		})
+	}
+}
+
+// TestResolveTimezoneTools tests that the tools accept resolved timezones.
+func TestResolveTimezoneTools(t *testing.T) {
+	output, err := ConvertTime("2025-07-08 15:00", "EDT", "UTC+05:30", InputFormat{}, Format{Layout: "RFC3339"})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if expected := "2025-07-09T00:30:00+05:30"; output != expected {
+		t.Errorf("expected %s, got %s", expected, output)
+	}
+}
@@ -0,0 +1,113 @@
+package datetime
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// roundModes lists the rounding modes of RoundTime.
+var roundModes = []string{"round", "floor", "ceil"}
+
+// GetRoundModes returns the rounding modes accepted by RoundTime.
+func GetRoundModes() []string { return roundModes }
+
+// RoundTime rounds a time to a multiple of an interval (e.g., "15m", "1h", "1d") on the wall clock of the given timezone,
+// so that intervals are aligned on local hours and midnights, whatever the UTC offset (e.g., +05:45 in Asia/Kathmandu).
+// Intervals are counted from the local midnight of January 1st, 1970: intervals dividing a day are aligned on every
+// local midnight. Weeks are not supported, as they would be aligned on Thursdays: use PeriodBounds instead.
+// Input times without timezone are interpreted in the given timezone.
+//
+// The mode is "round" (to the nearest multiple, halfway rounding up), "floor" or "ceil". When the result falls in a
+// wall clock skipped by a daylight saving time change, the end of the gap is returned. When it is repeated, the
+// occurrence closest to the input time in the direction of the mode is returned.
+func RoundTime(inputTime, interval, mode, timezone string, inputFormat InputFormat, format Format) (string, error) {
+	mode = strings.ToLower(strings.TrimSpace(mode))
+	if mode == "" {
+		mode = "round"
+	}
+	if mode != "round" && mode != "floor" && mode != "ceil" {
+		return "", fmt.Errorf("invalid_mode: Invalid rounding mode: %s, expected one of %s", mode, strings.Join(roundModes, ", "))
+	}
+
+	d, err := parseDuration(interval)
+	if err != nil {
+		return "", fmt.Errorf("invalid_interval: Invalid interval: %s", interval)
+	}
+	if d.years != 0 || d.months != 0 || int64(d.days) > math.MaxInt64/int64(24*time.Hour) {
+		return "", fmt.Errorf("invalid_interval: Interval must be positive, in days or smaller units: %s", interval)
+	}
+	// An interval overflowing a duration is negative.
+	step := time.Duration(d.days)*24*time.Hour + d.clock
+	if step <= 0 {
+		return "", fmt.Errorf("invalid_interval: Interval must be positive, in days or smaller units: %s", interval)
+	}
+	if step%(7*24*time.Hour) == 0 {
+		return "", fmt.Errorf("invalid_interval: Intervals of weeks are not supported, use the period_bounds tool to get the start of a week: %s", interval)
+	}
+
+	location, err := ResolveTimezone(timezone)
+	if err != nil {
+		return "", err
+	}
+
+	dt, err := fromStringWithTimezone(inputTime, inputFormat, timezone, "time")
+	if err != nil {
+		return "", err
+	}
+	t := dt.time.In(location)
+
+	// The wall clock is counted in nanoseconds since 1970, which overflows an int64 outside of 1678 to 2262.
+	wall := wallClock(t)
+	since := new(big.Int).Mul(big.NewInt(wall.Unix()), big.NewInt(int64(time.Second)))
+	since.Add(since, big.NewInt(int64(wall.Nanosecond())))
+
+	// The remainder of the Euclidean division is positive, also before 1970.
+	remainder := new(big.Int).Mod(since, big.NewInt(int64(step))).Int64()
+	target := new(big.Int).Sub(since, big.NewInt(remainder))
+	switch {
+	case mode == "ceil" && remainder != 0, mode == "round" && remainder >= int64(step)-remainder:
+		target.Add(target, big.NewInt(int64(step)))
+	}
+
+	seconds, nanoseconds := new(big.Int).DivMod(target, big.NewInt(int64(time.Second)), new(big.Int))
+	if !seconds.IsInt64() {
+		return "", fmt.Errorf("invalid_interval: Interval is too long: %s", interval)
+	}
+
+	dt.time = roundInstant(time.Unix(seconds.Int64(), nanoseconds.Int64()).UTC(), location, t, mode)
+
+	return dt.format(format, timezone)
+}
+
+// roundInstant returns the instant at which the wall clock in location shows wall. When the wall clock is repeated,
+// it returns the last occurrence at or before t for "floor", the first occurrence at or after t for "ceil", and the
+// closest occurrence to t for "round". A wall clock falling in a gap resolves to the end of the gap.
+func roundInstant(wall time.Time, location *time.Location, t time.Time, mode string) time.Time {
+	var best time.Time
+	for _, instant := range localInstants(wall, location) {
+		switch {
+		case mode == "floor" && instant.After(t), mode == "ceil" && instant.Before(t):
+			continue
+		case best.IsZero(), mode == "floor", mode == "round" && absDuration(instant.Sub(t)) < absDuration(best.Sub(t)):
+			best = instant
+		}
+	}
+
+	if best.IsZero() {
+		return fromWallClock(wall, location)
+	}
+
+	return best
+}
+
+// absDuration returns the absolute value of a duration.
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+
+	return d
+}
@@ -0,0 +1,78 @@
+package datetime
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestRoundTime tests the RoundTime function.
+func TestRoundTime(t *testing.T) {
+	tests := []struct {
+		name     string
+		time     string
+		interval string
+		mode     string
+		timezone string
+		expected string
+	}{
+		{"round down", "2025-07-09T10:07:00Z", "15m", "round", "", "2025-07-09T10:00:00Z"},
+		{"round halfway up", "2025-07-09T10:07:30Z", "15m", "", "", "2025-07-09T10:15:00Z"},
+		{"floor", "2025-07-09T10:14:59Z", "15m", "floor", "", "2025-07-09T10:00:00Z"},
+		{"ceil", "2025-07-09T10:00:01Z", "15m", "ceil", "", "2025-07-09T10:15:00Z"},
+		{"ceil exact", "2025-07-09T10:15:00Z", "15m", "ceil", "", "2025-07-09T10:15:00Z"},
+		{"interval not dividing an hour", "2025-07-09T10:50:00Z", "1h30m", "floor", "", "2025-07-09T10:30:00Z"},
+		{"kathmandu quarter", "2025-07-09T10:07:00Z", "15m", "round", "Asia/Kathmandu", "2025-07-09T15:45:00+05:45"},
+		{"kathmandu hour", "2025-07-09T10:07:00Z", "1h", "round", "Asia/Kathmandu", "2025-07-09T16:00:00+05:45"},
+		{"kathmandu hour floor", "2025-07-09T10:07:00Z", "1h", "floor", "Asia/Kathmandu", "2025-07-09T15:00:00+05:45"},
+		{"day in timezone", "2025-07-09T23:30:00Z", "1d", "floor", "Europe/Berlin", "2025-07-10T00:00:00+02:00"},
+		{"day round", "2025-07-09T12:30:00", "1d", "round", "Europe/Berlin", "2025-07-10T00:00:00+02:00"},
+		{"round into gap", "2025-03-09T01:55:00", "15m", "round", "America/New_York", "2025-03-09T03:00:00-04:00"},
+		{"floor on dst day", "2025-03-09T12:00:00", "1d", "floor", "America/New_York", "2025-03-09T00:00:00-05:00"},
+		{"repeated wall clock first", "2025-11-02T05:40:00Z", "15m", "round", "America/New_York", "2025-11-02T01:45:00-04:00"},
+		{"repeated wall clock second", "2025-11-02T06:40:00Z", "1h", "floor", "America/New_York", "2025-11-02T01:00:00-05:00"},
+		{"after 2262", "2300-06-15T10:07:00Z", "15m", "floor", "", "2300-06-15T10:00:00Z"},
+		{"before 1678", "1600-06-15T10:07:00Z", "15m", "ceil", "", "1600-06-15T10:15:00Z"},
+		{"before 1970", "1969-12-31T23:59:59.5Z", "1s", "round", "", "1970-01-01T00:00:00Z"},
+		{"interval not dividing an hour after 2262", "2300-06-15T10:07:00Z", "1h30m", "floor", "", "2300-06-15T09:00:00Z"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			output, err := RoundTime(test.time, test.interval, test.mode, test.timezone, InputFormat{}, Format{Layout: "RFC3339"})
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+
+			if output != test.expected {
+				t.Errorf("expected %s, got %s", test.expected, output)
+			}
+		})
+	}
+}
+
+// TestRoundTimeInvalid tests that RoundTime rejects invalid arguments.
+func TestRoundTimeInvalid(t *testing.T) {
+	tests := []struct {
+		interval       string
+		mode           string
+		expectedPrefix string
+	}{
+		{"15m", "nearest", "invalid_mode:"},
+		{"fifteen", "round", "invalid_interval:"},
+		{"1 month", "round", "invalid_interval:"},
+		{"0s", "round", "invalid_interval:"},
+		{"-15m", "round", "invalid_interval:"},
+		{"100000000d", "floor", "invalid_interval:"},
+		{"1w", "floor", "invalid_interval:"},
+		{"14d", "floor", "invalid_interval:"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.interval+" "+test.mode, func(t *testing.T) {
+			_, err := RoundTime("", test.interval, test.mode, "", InputFormat{}, Format{})
+			if err == nil || !strings.HasPrefix(err.Error(), test.expectedPrefix) {
+				t.Errorf("expected %s error, got %v", test.expectedPrefix, err)
+			}
+		})
+	}
+}
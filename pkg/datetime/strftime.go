@@ -0,0 +1,303 @@
+package datetime
+
+import (
+	"cmp"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/TheoBrigitte/mcp-time/pkg/locale"
+)
+
+// strftimeComposites maps the strftime specifiers which are shorthands for other specifiers.
+var strftimeComposites = map[byte]string{
+	'D': "%m/%d/%y",
+	'F': "%Y-%m-%d",
+	'R': "%H:%M",
+	'r': "%I:%M:%S %p",
+	'T': "%H:%M:%S",
+}
+
+// strftimeLocaleComposites maps the strftime specifiers of the preferred date and time representations to their
+// C locale shorthand, and to the pattern used with a locale.
+var strftimeLocaleComposites = map[byte][2]string{
+	'c': {"%a %b %e %H:%M:%S %Y", "DateTimeMedium"},
+	'x': {"%m/%d/%y", "DateShort"},
+	'X': {"%H:%M:%S", "TimeMedium"},
+}
+
+// formatStrftime formats t using a strftime format (e.g., "%Y-%m-%d %H:%M").
+//
+// The POSIX and common GNU specifiers are supported, including the ISO 8601 week-numbering year and week (%G, %g, %V),
+// the week of the year (%U, %W), the day of the year (%j) and the Unix timestamp (%s), as well as %f (microseconds)
+// and %N (nanoseconds). Numeric specifiers accept the GNU padding flags: "-" (no padding), "_" (spaces) and "0" (zeros),
+// and "%:z" writes the UTC offset with a colon.
+// Names are written in the language of the locale, and %c, %x and %X use the patterns of the locale.
+// If l is nil, English and the C locale are used.
+func formatStrftime(t time.Time, format string, l *locale.Locale) (string, error) {
+	names := cmp.Or(l, english)
+
+	var b strings.Builder
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			b.WriteByte(format[i])
+			continue
+		}
+
+		start := i
+		i++
+		var flag byte
+		if i < len(format) && strings.IndexByte("-_0", format[i]) >= 0 {
+			flag = format[i]
+			i++
+		}
+		colon := i < len(format) && format[i] == ':'
+		if colon {
+			i++
+		}
+		if i >= len(format) {
+			return "", fmt.Errorf("invalid_format: Incomplete strftime specifier %q at the end of the format", format[start:])
+		}
+
+		spec := format[i]
+		if colon && spec != 'z' {
+			return "", fmt.Errorf("invalid_format: Unsupported strftime specifier %q", format[start:i+1])
+		}
+
+		if n, width, pad, ok := strftimeNumber(t, spec); ok {
+			b.WriteString(padNumber(n, width, pad, flag))
+			continue
+		}
+
+		switch spec {
+		case 'a':
+			b.WriteString(names.ShortWeekdays[t.Weekday()])
+		case 'A':
+			b.WriteString(names.Weekdays[t.Weekday()])
+		case 'b', 'h':
+			b.WriteString(names.ShortMonths[t.Month()-1])
+		case 'B':
+			b.WriteString(names.Months[t.Month()-1])
+		case 'p':
+			b.WriteString(names.Format(t, "PM"))
+		case 'P':
+			b.WriteString(names.Format(t, "pm"))
+		case 'z':
+			if colon {
+				b.WriteString(t.Format("-07:00"))
+			} else {
+				b.WriteString(t.Format("-0700"))
+			}
+		case 'Z':
+			b.WriteString(t.Format("MST"))
+		case 'n':
+			b.WriteByte('\n')
+		case 't':
+			b.WriteByte('\t')
+		case '%':
+			b.WriteByte('%')
+		default:
+			composite, ok := strftimeComposites[spec]
+			if c, localized := strftimeLocaleComposites[spec]; localized {
+				if l != nil {
+					layout, _ := l.Layout(c[1])
+					b.WriteString(l.Format(t, layout))
+					continue
+				}
+				composite, ok = c[0], true
+			}
+			if !ok {
+				return "", fmt.Errorf("invalid_format: Unsupported strftime specifier %q", format[start:i+1])
+			}
+
+			output, _ := formatStrftime(t, composite, l)
+			b.WriteString(output)
+		}
+	}
+
+	return b.String(), nil
+}
+
+// strftimeNumber returns the value, the width and the padding character of a numeric strftime specifier.
+func strftimeNumber(t time.Time, spec byte) (n int64, width int, pad byte, ok bool) {
+	isoYear, isoWeek := t.ISOWeek()
+	hour12 := (t.Hour()+11)%12 + 1
+	// Days since the start of the year, and days since the last Monday.
+	yearDay, mondayDays := t.YearDay()-1, (int(t.Weekday())+6)%7
+
+	switch spec {
+	case 'C':
+		return int64(t.Year() / 100), 2, '0', true
+	case 'd':
+		return int64(t.Day()), 2, '0', true
+	case 'e':
+		return int64(t.Day()), 2, ' ', true
+	case 'f':
+		return int64(t.Nanosecond() / 1000), 6, '0', true
+	case 'g':
+		return int64(isoYear % 100), 2, '0', true
+	case 'G':
+		return int64(isoYear), 4, '0', true
+	case 'H':
+		return int64(t.Hour()), 2, '0', true
+	case 'I':
+		return int64(hour12), 2, '0', true
+	case 'j':
+		return int64(t.YearDay()), 3, '0', true
+	case 'k':
+		return int64(t.Hour()), 2, ' ', true
+	case 'l':
+		return int64(hour12), 2, ' ', true
+	case 'm':
+		return int64(t.Month()), 2, '0', true
+	case 'M':
+		return int64(t.Minute()), 2, '0', true
+	case 'N':
+		return int64(t.Nanosecond()), 9, '0', true
+	case 's':
+		return t.Unix(), 1, '0', true
+	case 'S':
+		return int64(t.Second()), 2, '0', true
+	case 'u':
+		return int64(mondayDays + 1), 1, '0', true
+	case 'U':
+		// Week of the year, starting on the first Sunday.
+		return int64((yearDay - int(t.Weekday()) + 7) / 7), 2, '0', true
+	case 'V':
+		return int64(isoWeek), 2, '0', true
+	case 'w':
+		return int64(t.Weekday()), 1, '0', true
+	case 'W':
+		// Week of the year, starting on the first Monday.
+		return int64((yearDay - mondayDays + 7) / 7), 2, '0', true
+	case 'y':
+		return int64(t.Year() % 100), 2, '0', true
+	case 'Y':
+		return int64(t.Year()), 4, '0', true
+	}
+
+	return 0, 0, 0, false
+}
+
+// padNumber writes n padded to width, with the padding character overridden by a GNU flag.
+func padNumber(n int64, width int, pad byte, flag byte) string {
+	s := strconv.FormatInt(n, 10)
+	switch flag {
+	case '-':
+		return s
+	case '_':
+		pad = ' '
+	case '0':
+		pad = '0'
+	}
+
+	if len(s) >= width {
+		return s
+	}
+
+	return strings.Repeat(string(pad), width-len(s)) + s
+}
+
+// strftimeLayouts maps the strftime specifiers which can be read to their Go layout, and to the layout without
+// padding used with the "-" flag.
+var strftimeLayouts = map[byte][2]string{
+	'a': {"Mon", "Mon"},
+	'A': {"Monday", "Monday"},
+	'b': {"Jan", "Jan"},
+	'B': {"January", "January"},
+	'd': {"02", "2"},
+	'e': {"_2", "2"},
+	'f': {"000000", "000000"},
+	'h': {"Jan", "Jan"},
+	'H': {"15", "15"},
+	'I': {"03", "3"},
+	'j': {"002", "002"},
+	'm': {"01", "1"},
+	'M': {"04", "4"},
+	'N': {"000000000", "000000000"},
+	'p': {"PM", "PM"},
+	'P': {"pm", "pm"},
+	'S': {"05", "5"},
+	'y': {"06", "06"},
+	'Y': {"2006", "2006"},
+	'z': {"-0700", "-0700"},
+	'Z': {"MST", "MST"},
+}
+
+// strftimeReference is the time used to detect the Go layout elements in the literal text of a strftime format.
+var strftimeReference = time.Date(1999, time.November, 30, 9, 58, 57, 0, time.FixedZone("XYZ", 4*60*60))
+
+// strftimeLayout converts a strftime format to the Go layout used to read times (e.g., "%d/%m/%Y" to "02/01/2006").
+//
+// The specifiers of names, dates, times and time zones are supported, as well as the composite specifiers
+// in the C locale (e.g., %F, %T, %c). The week-based specifiers (e.g., %V, %U) and the Unix timestamp (%s),
+// which can not be read as parts of a date, are rejected, and so is literal text that Go would read as a layout element.
+func strftimeLayout(format string) (string, error) {
+	var b strings.Builder
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			start := i
+			for i < len(format) && format[i] != '%' {
+				i++
+			}
+			literal := format[start:i]
+			if strftimeReference.Format(literal) != literal {
+				return "", fmt.Errorf("invalid_input_format: Unable to read the literal text %q of the strftime format", literal)
+			}
+			b.WriteString(literal)
+			i--
+			continue
+		}
+
+		start := i
+		i++
+		var flag byte
+		if i < len(format) && strings.IndexByte("-_0", format[i]) >= 0 {
+			flag = format[i]
+			i++
+		}
+		colon := i < len(format) && format[i] == ':'
+		if colon {
+			i++
+		}
+		if i >= len(format) {
+			return "", fmt.Errorf("invalid_input_format: Incomplete strftime specifier %q at the end of the format", format[start:])
+		}
+
+		spec := format[i]
+		switch {
+		case colon && spec == 'z':
+			b.WriteString("-07:00")
+		case colon:
+			return "", fmt.Errorf("invalid_input_format: Unsupported strftime specifier %q", format[start:i+1])
+		case spec == '%':
+			b.WriteByte('%')
+		case spec == 'n':
+			b.WriteByte('\n')
+		case spec == 't':
+			b.WriteByte('\t')
+		case flag == '_' && (spec == 'd' || spec == 'e'):
+			b.WriteString("_2")
+		case strftimeLayouts[spec] != [2]string{}:
+			if flag == '-' {
+				b.WriteString(strftimeLayouts[spec][1])
+			} else {
+				b.WriteString(strftimeLayouts[spec][0])
+			}
+		default:
+			composite, ok := strftimeComposites[spec]
+			if c, localized := strftimeLocaleComposites[spec]; localized {
+				composite, ok = c[0], true
+			}
+			if !ok {
+				return "", fmt.Errorf("invalid_input_format: Unsupported strftime specifier %q for input times", format[start:i+1])
+			}
+
+			layout, _ := strftimeLayout(composite)
+			b.WriteString(layout)
+		}
+	}
+
+	return b.String(), nil
+}
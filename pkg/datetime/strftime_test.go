@@ -0,0 +1,118 @@
+package datetime
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TheoBrigitte/mcp-time/pkg/locale"
+)
+
+// TestFormatStrftime tests the strftime specifiers.
+func TestFormatStrftime(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Fatalf("invalid location: %v", err)
+	}
+
+	// Sunday, in the first week of the year starting on Sunday.
+	sunday := time.Date(2025, time.January, 5, 9, 7, 3, 123456789, time.UTC)
+	// Monday, in the first ISO week of 2025.
+	monday := time.Date(2024, time.December, 30, 15, 4, 5, 0, paris)
+
+	tests := []struct {
+		time     time.Time
+		format   string
+		locale   string
+		expected string
+	}{
+		{sunday, "%Y-%m-%d %H:%M:%S", "", "2025-01-05 09:07:03"},
+		{sunday, "%C %y %j %u %w %U %W %V %G %g", "", "20 25 005 7 0 01 00 01 2025 25"},
+		{sunday, "%e|%-d|%_m|%0e|%k|%l|%-H|%-j", "", " 5|5| 1|05| 9| 9|9|5"},
+		{sunday, "%I:%M %p %P", "", "09:07 AM am"},
+		{sunday, "%a %A %b %h %B", "", "Sun Sunday Jan Jan January"},
+		{sunday, "%S.%f %N", "", "03.123456 123456789"},
+		{sunday, "%D %F %T %R %r", "", "01/05/25 2025-01-05 09:07:03 09:07 09:07:03 AM"},
+		{sunday, "%c|%x|%X", "", "Sun Jan  5 09:07:03 2025|01/05/25|09:07:03"},
+		{sunday, "100%% %n%t", "", "100% \n\t"},
+		{monday, "%G-W%V-%u %g", "", "2025-W01-1 25"},
+		{monday, "%Y %U %W %j", "", "2024 52 53 365"},
+		{monday, "%z %:z %Z %s", "", "+0100 +01:00 CET 1735567445"},
+		{monday, "%l:%M %p", "", " 3:04 PM"},
+		{sunday, "%A %e %B %Y", "fr", "dimanche  5 janvier 2025"},
+		{sunday, "%a %d %b", "de", "So. 05 Jan."},
+		{sunday, "%c", "fr", "5 janv. 2025 09:07:03"},
+		{monday, "%x %p", "ja", "2024/12/30 午後"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.format+" "+test.locale, func(t *testing.T) {
+			var l *locale.Locale
+			if test.locale != "" {
+				l, _ = locale.Lookup(test.locale)
+			}
+
+			output, err := formatStrftime(test.time, test.format, l)
+			if err != nil {
+				t.Errorf("unexpected error %v", err)
+				return
+			}
+
+			if output != test.expected {
+				t.Errorf("expected output %q, got %q", test.expected, output)
+			}
+		})
+	}
+}
+
+// TestFormatStrftimeInvalid tests that unsupported strftime specifiers are rejected.
+func TestFormatStrftimeInvalid(t *testing.T) {
+	for _, format := range []string{"%Q", "%Y-%", "%-", "%:d", "%E"} {
+		t.Run(format, func(t *testing.T) {
+			_, err := formatStrftime(time.Now(), format, nil)
+			if err == nil || !strings.HasPrefix(err.Error(), "invalid_format:") {
+				t.Errorf("expected invalid_format error, got %v", err)
+			}
+		})
+	}
+}
+
+// TestFormatStyle tests the selection of the style of the format.
+func TestFormatStyle(t *testing.T) {
+	dt := fromTime(time.Date(2025, 6, 7, 12, 34, 56, 00, time.UTC))
+
+	tests := []struct {
+		format         Format
+		expectedOutput string
+	}{
+		{Format{Layout: "%Y-%m-%d", Style: "strftime"}, "2025-06-07"},
+		{Format{Layout: "%Y-%m-%d", Style: "STRFTIME"}, "2025-06-07"},
+		{Format{Layout: "strftime:%d/%m/%Y %H:%M"}, "07/06/2025 12:34"},
+		{Format{Layout: "strftime:%A", Locale: "de"}, "Samstag"},
+		{Format{Layout: "go:2006-01-02"}, "2025-06-07"},
+		{Format{Layout: "%Y-%m-%d"}, "%Y-%m-%d"},
+		{Format{Layout: "15:04"}, "12:34"},
+		// Predefined names are recognized in every style.
+		{Format{Layout: "RFC3339", Style: "strftime"}, "2025-06-07T12:34:56Z"},
+		{Format{Layout: "DateLong", Style: "strftime", Locale: "fr"}, "7 juin 2025"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.format.Layout, func(t *testing.T) {
+			output, err := dt.format(test.format, "")
+			if err != nil {
+				t.Errorf("unexpected error %v", err)
+				return
+			}
+
+			if output != test.expectedOutput {
+				t.Errorf("expected output %q, got %q", test.expectedOutput, output)
+			}
+		})
+	}
+
+	_, err := dt.format(Format{Layout: "%Y", Style: "posix"}, "")
+	if err == nil || !strings.HasPrefix(err.Error(), "invalid_format_style:") {
+		t.Errorf("expected invalid_format_style error, got %v", err)
+	}
+}
@@ -0,0 +1,298 @@
+package datetime
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/TheoBrigitte/mcp-time/pkg/places"
+)
+
+//go:generate go run gen_timezones.go
+
+// maxTimezoneSuggestions is the maximum number of timezone names suggested for an invalid timezone.
+const maxTimezoneSuggestions = 3
+
+// TimezoneInfo describes the state of a timezone at a given time.
+type TimezoneInfo struct {
+	// Timezone is the name of the timezone.
+	Timezone string `json:"timezone"`
+	// Time is the reference time, in the timezone.
+	Time string `json:"time"`
+	// Abbreviation is the abbreviated name of the zone in use (e.g., "CEST").
+	Abbreviation string `json:"abbreviation"`
+	// Offset is the UTC offset in use (e.g., "+02:00").
+	Offset string `json:"offset"`
+	// OffsetSeconds is the UTC offset in use, in seconds east of UTC.
+	OffsetSeconds int `json:"offset_seconds"`
+	// IsDST is set when daylight saving time is in effect.
+	IsDST bool `json:"is_dst"`
+	// PreviousTransition is the last transition at or before the reference time, if any.
+	PreviousTransition *Transition `json:"previous_transition"`
+	// NextTransition is the first transition after the reference time, if any.
+	NextTransition *Transition `json:"next_transition"`
+}
+
+// Transition describes a change of the UTC offset or abbreviation of a timezone.
+type Transition struct {
+	// Time is the instant of the transition, in the timezone.
+	Time string `json:"time"`
+	// Shift is the change of the UTC offset as a Go duration string (e.g., "1h0m0s" when clocks are moved forward).
+	Shift string `json:"shift"`
+	// OffsetBefore and OffsetAfter are the UTC offsets before and after the transition (e.g., "+01:00").
+	OffsetBefore string `json:"offset_before"`
+	OffsetAfter  string `json:"offset_after"`
+	// AbbreviationBefore and AbbreviationAfter are the zone abbreviations before and after the transition.
+	AbbreviationBefore string `json:"abbreviation_before"`
+	AbbreviationAfter  string `json:"abbreviation_after"`
+	// IsDST is set when daylight saving time is in effect after the transition.
+	IsDST bool `json:"is_dst"`
+}
+
+// formatOffset formats a UTC offset in seconds as ±hh:mm, or ±hh:mm:ss when it has seconds.
+func formatOffset(offset int) string {
+	sign := '+'
+	if offset < 0 {
+		sign, offset = '-', -offset
+	}
+
+	if offset%60 != 0 {
+		return fmt.Sprintf("%c%02d:%02d:%02d", sign, offset/3600, offset/60%60, offset%60)
+	}
+
+	return fmt.Sprintf("%c%02d:%02d", sign, offset/3600, offset/60%60)
+}
+
+// newTransition describes the transition happening at instant t, formatted using format and timezone.
+func newTransition(t time.Time, format Format, timezone string) (*Transition, error) {
+	beforeName, beforeOffset := t.Add(-time.Nanosecond).Zone()
+	afterName, afterOffset := t.Zone()
+
+	o, err := fromTime(t).format(format, timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Transition{
+		Time:               o,
+		Shift:              (time.Duration(afterOffset-beforeOffset) * time.Second).String(),
+		OffsetBefore:       formatOffset(beforeOffset),
+		OffsetAfter:        formatOffset(afterOffset),
+		AbbreviationBefore: beforeName,
+		AbbreviationAfter:  afterName,
+		IsDST:              t.IsDST(),
+	}, nil
+}
+
+// GetTimezoneInfo returns the UTC offset, abbreviation and daylight saving time state of a timezone at a given time,
+// along with the surrounding transitions. Input times without timezone are interpreted in the given timezone.
+// Times are returned in the specified format.
+func GetTimezoneInfo(timezone, inputTime string, inputFormat InputFormat, format Format) (*TimezoneInfo, error) {
+	location := defaultLocation
+	if timezone != "" {
+		var err error
+		location, err = ResolveTimezone(timezone)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	dt, err := fromStringWithTimezone(inputTime, inputFormat, timezone, "time")
+	if err != nil {
+		return nil, err
+	}
+	t := dt.time.In(location)
+
+	name, offset := t.Zone()
+	info := &TimezoneInfo{
+		Timezone:      location.String(),
+		Abbreviation:  name,
+		Offset:        formatOffset(offset),
+		OffsetSeconds: offset,
+		IsDST:         t.IsDST(),
+	}
+
+	info.Time, err = dt.format(format, timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	// The bounds of the zone in use are the surrounding transitions.
+	start, end := t.ZoneBounds()
+	if !start.IsZero() {
+		info.PreviousTransition, err = newTransition(start, format, timezone)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !end.IsZero() {
+		info.NextTransition, err = newTransition(end, format, timezone)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return info, nil
+}
+
+// TimezoneEntry describes an available timezone.
+type TimezoneEntry struct {
+	// Name is the IANA name of the timezone.
+	Name string `json:"name"`
+	// Abbreviation is the abbreviated name of the zone in use at the reference time (e.g., "CEST").
+	Abbreviation string `json:"abbreviation"`
+	// Offset is the UTC offset in use at the reference time (e.g., "+02:00").
+	Offset string `json:"offset"`
+}
+
+// ListTimezones returns the timezones available in the embedded timezone database, with their state at a given time.
+// The list is filtered by region prefix (e.g., "America/"), case insensitive substring (e.g., "york"), and
+// UTC offset in use at the given time (e.g., "+05:30", "-3", "UTC+1"). Empty filters match all timezones.
+func ListTimezones(region, contains, offset, inputTime string, inputFormat InputFormat) ([]TimezoneEntry, error) {
+	wantOffset := 0
+	if offset != "" {
+		var ok bool
+		wantOffset, ok = parseOffset(offset)
+		if !ok {
+			return nil, fmt.Errorf("invalid_offset: Invalid UTC offset: %s", offset)
+		}
+	}
+
+	dt, err := fromString(inputTime, inputFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	region = strings.ToLower(region)
+	contains = strings.ToLower(contains)
+
+	entries := []TimezoneEntry{}
+	for _, name := range timezoneNames {
+		lower := strings.ToLower(name)
+		if !strings.HasPrefix(lower, region) || !strings.Contains(lower, contains) {
+			continue
+		}
+
+		location, err := time.LoadLocation(name)
+		if err != nil {
+			continue
+		}
+
+		abbreviation, zoneOffset := dt.time.In(location).Zone()
+		if offset != "" && zoneOffset != wantOffset {
+			continue
+		}
+
+		entries = append(entries, TimezoneEntry{
+			Name:         name,
+			Abbreviation: abbreviation,
+			Offset:       formatOffset(zoneOffset),
+		})
+	}
+
+	return entries, nil
+}
+
+// parseOffset parses a UTC offset, optionally prefixed by "UTC" or "GMT", in the forms ±hh, ±hhmm, ±hh:mm and ±h:mm
+// (e.g., "+05:30", "-0300", "UTC-3"). It returns the offset in seconds east of UTC.
+func parseOffset(s string) (int, bool) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	for _, prefix := range []string{"UTC", "GMT"} {
+		s = strings.TrimPrefix(s, prefix)
+	}
+
+	switch s {
+	case "", "Z":
+		return 0, true
+	}
+
+	sign := 1
+	switch s[0] {
+	case '-':
+		sign = -1
+	case '+':
+	default:
+		return 0, false
+	}
+	s = s[1:]
+
+	hours, minutes, found := strings.Cut(s, ":")
+	if !found && len(s) > 2 {
+		hours, minutes = s[:len(s)-2], s[len(s)-2:]
+	}
+	if hours == "" || len(hours) > 2 || (minutes != "" && len(minutes) != 2) || (found && minutes == "") {
+		return 0, false
+	}
+
+	h, err := strconv.Atoi(hours)
+	if err != nil || h < 0 || h > 14 {
+		return 0, false
+	}
+
+	m := 0
+	if minutes != "" {
+		m, err = strconv.Atoi(minutes)
+		if err != nil || m < 0 || m > 59 {
+			return 0, false
+		}
+	}
+
+	return sign * (h*3600 + m*60), true
+}
+
+// didYouMean returns a hint listing the timezone names closest to an invalid timezone name
+// (e.g., ` (did you mean "Europe/Paris"?)`), or an empty string when none is close enough.
+func didYouMean(timezone string) string {
+	suggestions := suggestTimezones(timezone)
+	if len(suggestions) == 0 {
+		return ""
+	}
+
+	quoted := make([]string, 0, len(suggestions))
+	for _, s := range suggestions {
+		quoted = append(quoted, strconv.Quote(s))
+	}
+
+	return fmt.Sprintf(" (did you mean %s?)", strings.Join(quoted, " or "))
+}
+
+// suggestTimezones returns the timezone names closest to an invalid timezone name, the closest first.
+// Names are compared case insensitively, with spaces as underscores, either in full or by their last
+// element (e.g., "new york" suggests "America/New_York").
+func suggestTimezones(timezone string) []string {
+	input := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(timezone), " ", "_"))
+	if input == "" {
+		return nil
+	}
+
+	// Allow about one typo every three characters.
+	maxDistance := max(1, len(input)/3)
+
+	type suggestion struct {
+		name     string
+		distance int
+	}
+	var suggestions []suggestion
+	for _, name := range timezoneNames {
+		lower := strings.ToLower(name)
+		distance := places.Levenshtein(input, lower)
+		if i := strings.LastIndexByte(lower, '/'); i >= 0 && !strings.Contains(input, "/") {
+			distance = min(distance, places.Levenshtein(input, lower[i+1:]))
+		}
+
+		if distance <= maxDistance {
+			suggestions = append(suggestions, suggestion{name, distance})
+		}
+	}
+
+	slices.SortStableFunc(suggestions, func(a, b suggestion) int { return a.distance - b.distance })
+
+	names := make([]string, 0, maxTimezoneSuggestions)
+	for i := 0; i < len(suggestions) && i < maxTimezoneSuggestions; i++ {
+		names = append(names, suggestions[i].name)
+	}
+
+	return names
+}
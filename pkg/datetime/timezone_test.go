@@ -0,0 +1,233 @@
+package datetime
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestGetTimezoneInfo tests the GetTimezoneInfo function.
+func TestGetTimezoneInfo(t *testing.T) {
+	tests := []struct {
+		name                 string
+		timezone             string
+		inputTime            string
+		expectedAbbreviation string
+		expectedOffset       string
+		expectedIsDST        bool
+		expectedPrevious     *Transition
+		expectedNext         *Transition
+	}{
+		{
+			"summer time",
+			"Europe/Paris",
+			"2025-07-08T12:00:00Z",
+			"CEST",
+			"+02:00",
+			true,
+			&Transition{Time: "2025-03-30T03:00:00+02:00", Shift: "1h0m0s", OffsetBefore: "+01:00", OffsetAfter: "+02:00", AbbreviationBefore: "CET", AbbreviationAfter: "CEST", IsDST: true},
+			&Transition{Time: "2025-10-26T02:00:00+01:00", Shift: "-1h0m0s", OffsetBefore: "+02:00", OffsetAfter: "+01:00", AbbreviationBefore: "CEST", AbbreviationAfter: "CET", IsDST: false},
+		},
+		{
+			"standard time",
+			"America/New_York",
+			"2025-01-15T12:00:00",
+			"EST",
+			"-05:00",
+			false,
+			&Transition{Time: "2024-11-03T01:00:00-05:00", Shift: "-1h0m0s", OffsetBefore: "-04:00", OffsetAfter: "-05:00", AbbreviationBefore: "EDT", AbbreviationAfter: "EST", IsDST: false},
+			&Transition{Time: "2025-03-09T03:00:00-04:00", Shift: "1h0m0s", OffsetBefore: "-05:00", OffsetAfter: "-04:00", AbbreviationBefore: "EST", AbbreviationAfter: "EDT", IsDST: true},
+		},
+		{
+			"southern hemisphere",
+			"Australia/Sydney",
+			"2025-01-15T12:00:00",
+			"AEDT",
+			"+11:00",
+			true,
+			&Transition{Time: "2024-10-06T03:00:00+11:00", Shift: "1h0m0s", OffsetBefore: "+10:00", OffsetAfter: "+11:00", AbbreviationBefore: "AEST", AbbreviationAfter: "AEDT", IsDST: true},
+			&Transition{Time: "2025-04-06T02:00:00+10:00", Shift: "-1h0m0s", OffsetBefore: "+11:00", OffsetAfter: "+10:00", AbbreviationBefore: "AEDT", AbbreviationAfter: "AEST", IsDST: false},
+		},
+		{
+			"half hour offset without DST",
+			"Asia/Kolkata",
+			"2025-07-08T12:00:00Z",
+			"IST",
+			"+05:30",
+			false,
+			&Transition{Time: "1945-10-14T23:00:00+05:30", Shift: "-1h0m0s", OffsetBefore: "+06:30", OffsetAfter: "+05:30", AbbreviationBefore: "+0630", AbbreviationAfter: "IST", IsDST: false},
+			nil,
+		},
+		{
+			"UTC",
+			"",
+			"2025-07-08T12:00:00Z",
+			"UTC",
+			"+00:00",
+			false,
+			nil,
+			nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			info, err := GetTimezoneInfo(test.timezone, test.inputTime, InputFormat{}, Format{Layout: "RFC3339"})
+			if err != nil {
+				t.Errorf("unexpected error %v", err)
+				return
+			}
+
+			if info.Abbreviation != test.expectedAbbreviation || info.Offset != test.expectedOffset || info.IsDST != test.expectedIsDST {
+				t.Errorf("expected %s %s dst=%v, got %s %s dst=%v", test.expectedAbbreviation, test.expectedOffset, test.expectedIsDST, info.Abbreviation, info.Offset, info.IsDST)
+			}
+
+			for _, transition := range []struct {
+				name               string
+				expected, computed *Transition
+			}{
+				{"previous", test.expectedPrevious, info.PreviousTransition},
+				{"next", test.expectedNext, info.NextTransition},
+			} {
+				if (transition.expected == nil) != (transition.computed == nil) || (transition.expected != nil && *transition.expected != *transition.computed) {
+					t.Errorf("expected %s transition %+v, got %+v", transition.name, transition.expected, transition.computed)
+				}
+			}
+		})
+	}
+}
+
+// TestFormatOffset tests the formatOffset function.
+func TestFormatOffset(t *testing.T) {
+	tests := map[int]string{
+		0:      "+00:00",
+		3600:   "+01:00",
+		-16200: "-04:30",
+		20700:  "+05:45",
+		-2670:  "-00:44:30",
+	}
+
+	for offset, expected := range tests {
+		if o := formatOffset(offset); o != expected {
+			t.Errorf("expected %s for %d, got %s", expected, offset, o)
+		}
+	}
+}
+
+// TestListTimezones tests the ListTimezones function.
+func TestListTimezones(t *testing.T) {
+	tests := []struct {
+		name          string
+		region        string
+		contains      string
+		offset        string
+		expectedNames []string
+	}{
+		{"substring", "", "new_york", "", []string{"America/New_York"}},
+		{"region and substring", "australia/", "syd", "", []string{"Australia/Sydney"}},
+		{"region and offset", "Asia/", "", "+05:45", []string{"Asia/Kathmandu", "Asia/Katmandu"}},
+		{"offset with prefix", "America/", "arenas", "UTC-3", []string{"America/Punta_Arenas"}},
+		{"no match", "Europe/", "york", "", []string{}},
+		{"no factory placeholder", "", "factory", "", []string{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			entries, err := ListTimezones(test.region, test.contains, test.offset, "2025-07-08T12:00:00Z", InputFormat{})
+			if err != nil {
+				t.Errorf("unexpected error %v", err)
+				return
+			}
+
+			names := []string{}
+			for _, entry := range entries {
+				names = append(names, entry.Name)
+			}
+
+			if strings.Join(names, ", ") != strings.Join(test.expectedNames, ", ") {
+				t.Errorf("expected %q, got %q", test.expectedNames, names)
+			}
+		})
+	}
+
+	_, err := ListTimezones("", "", "+25:00", "", InputFormat{})
+	if err == nil || !strings.HasPrefix(err.Error(), "invalid_offset:") {
+		t.Errorf("expected invalid_offset error, got %v", err)
+	}
+}
+
+// TestTimezoneNames tests that all listed timezone names can be loaded.
+func TestTimezoneNames(t *testing.T) {
+	for _, name := range timezoneNames {
+		if _, err := time.LoadLocation(name); err != nil {
+			t.Errorf("unable to load timezone %s: %v", name, err)
+		}
+	}
+}
+
+// TestParseOffset tests the parseOffset function.
+func TestParseOffset(t *testing.T) {
+	tests := map[string]int{
+		"+05:30":   19800,
+		"-0300":    -10800,
+		"+5":       18000,
+		"UTC-3":    -10800,
+		"gmt+5:45": 20700,
+		"UTC":      0,
+		"Z":        0,
+	}
+
+	for input, expected := range tests {
+		offset, ok := parseOffset(input)
+		if !ok || offset != expected {
+			t.Errorf("expected %d for %q, got %d (ok: %v)", expected, input, offset, ok)
+		}
+	}
+
+	for _, input := range []string{"5", "+", "+15", "+05:60", "+05:", "+-5", "+123456", "EST"} {
+		if _, ok := parseOffset(input); ok {
+			t.Errorf("expected %q to be invalid", input)
+		}
+	}
+}
+
+// TestSuggestTimezones tests the suggestTimezones function.
+func TestSuggestTimezones(t *testing.T) {
+	tests := map[string]string{
+		"europe/paris":      "Europe/Paris",
+		"Europe/Pari":       "Europe/Paris",
+		"America/New York":  "America/New_York",
+		"new york":          "America/New_York",
+		"Asia/Tokio":        "Asia/Tokyo",
+		"Amrica/Los_Angels": "America/Los_Angeles",
+	}
+
+	for input, expected := range tests {
+		suggestions := suggestTimezones(input)
+		if len(suggestions) == 0 || suggestions[0] != expected {
+			t.Errorf("expected %q to suggest %s first, got %q", input, expected, suggestions)
+		}
+	}
+
+	if suggestions := suggestTimezones("Not/A_Timezone_At_All"); len(suggestions) != 0 {
+		t.Errorf("expected no suggestions, got %q", suggestions)
+	}
+
+	_, err := ConvertTime("2025-07-08T12:00:00Z", "", "Europe/Pari", InputFormat{}, Format{})
+	if err == nil || !strings.Contains(err.Error(), `did you mean "Europe/Paris"`) {
+		t.Errorf("expected a suggestion in error, got %v", err)
+	}
+}
+
+// TestLocationTimezone tests the LocationTimezone function.
+func TestLocationTimezone(t *testing.T) {
+	timezone, err := LocationTimezone("Lagos")
+	if err != nil || timezone != "Africa/Lagos" {
+		t.Errorf("expected Africa/Lagos, got %s (%v)", timezone, err)
+	}
+
+	_, err = LocationTimezone("Xyzzyville")
+	if err == nil || !strings.HasPrefix(err.Error(), "unknown_location:") {
+		t.Errorf("expected unknown_location error, got %v", err)
+	}
+}
@@ -0,0 +1,137 @@
+package datetime
+
+import (
+	"fmt"
+	"time"
+)
+
+// maxWorldClockTimezones is the maximum number of timezones accepted by WorldClock.
+const maxWorldClockTimezones = 50
+
+// defaultWorkStart and defaultWorkEnd are the working hours used when none are specified.
+const (
+	defaultWorkStart = "09:00"
+	defaultWorkEnd   = "17:00"
+)
+
+// GetDefaultWorkingHours returns the default start and end of working hours.
+func GetDefaultWorkingHours() (string, string) { return defaultWorkStart, defaultWorkEnd }
+
+// WorldClockEntry is the local time of a timezone in a world clock.
+type WorldClockEntry struct {
+	// Timezone is the name of the timezone.
+	Timezone string `json:"timezone"`
+	// Time is the local time, in the timezone.
+	Time string `json:"time"`
+	// Weekday is the local day of the week (e.g., "Monday").
+	Weekday string `json:"weekday"`
+	// Abbreviation is the abbreviated name of the zone in use (e.g., "CEST").
+	Abbreviation string `json:"abbreviation"`
+	// Offset is the UTC offset in use (e.g., "+02:00").
+	Offset string `json:"offset"`
+	// DayDifference is the number of days between the local date and the local date of the first timezone
+	// (e.g., 1 when it is already tomorrow).
+	DayDifference int `json:"day_difference"`
+	// WorkingHours is set when the local time is within working hours, on a day which is not a weekend day.
+	WorkingHours bool `json:"working_hours"`
+}
+
+// WorldClock returns the local time of a list of timezones at a given time (the current time by default).
+// Input times without timezone are interpreted in the first timezone, and times are returned in the specified format.
+//
+// Working hours are given as "HH:MM" (e.g., "09:00" and "17:00"), the end being excluded, and span midnight
+// when the end is before the start (e.g., "22:00" to "06:00"). If weekend is nil, Saturday and Sunday are used.
+func WorldClock(timezones []string, inputTime, workStart, workEnd string, weekend []string, inputFormat InputFormat, format Format) ([]WorldClockEntry, error) {
+	if len(timezones) == 0 || len(timezones) > maxWorldClockTimezones {
+		return nil, fmt.Errorf("invalid_timezones: Between 1 and %d timezones are required", maxWorldClockTimezones)
+	}
+
+	start, end, err := parseWorkingHours(workStart, workEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar, err := newBusinessCalendar(weekend, "")
+	if err != nil {
+		return nil, err
+	}
+
+	dt, err := fromStringWithTimezone(inputTime, inputFormat, timezones[0], "time")
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]WorldClockEntry, 0, len(timezones))
+	var firstDate time.Time
+	for i, timezone := range timezones {
+		location, err := ResolveTimezone(timezone)
+		if err != nil {
+			return nil, err
+		}
+		t := dt.time.In(location)
+
+		output, err := dt.format(format, timezone)
+		if err != nil {
+			return nil, err
+		}
+
+		// Dates are compared as UTC midnights, so that offsets do not matter.
+		date := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		if i == 0 {
+			firstDate = date
+		}
+
+		abbreviation, offset := t.Zone()
+		entries = append(entries, WorldClockEntry{
+			Timezone:      location.String(),
+			Time:          output,
+			Weekday:       t.Weekday().String(),
+			Abbreviation:  abbreviation,
+			Offset:        formatOffset(offset),
+			DayDifference: int(date.Sub(firstDate) / (24 * time.Hour)),
+			WorkingHours:  calendar.isBusinessDay(t) && withinWorkingHours(t, start, end),
+		})
+	}
+
+	return entries, nil
+}
+
+// parseWorkingHours parses the start and end of working hours as "HH:MM", and returns them as durations since midnight.
+func parseWorkingHours(workStart, workEnd string) (start, end time.Duration, err error) {
+	if workStart == "" {
+		workStart = defaultWorkStart
+	}
+	if workEnd == "" {
+		workEnd = defaultWorkEnd
+	}
+
+	for _, h := range []struct {
+		value string
+		d     *time.Duration
+	}{
+		{workStart, &start},
+		{workEnd, &end},
+	} {
+		t, err := time.Parse("15:04", h.value)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid_working_hours: Invalid time of day, expected HH:MM: %s", h.value)
+		}
+		*h.d = time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+	}
+
+	if start == end {
+		return 0, 0, fmt.Errorf("invalid_working_hours: Working hours must not start and end at the same time")
+	}
+
+	return start, end, nil
+}
+
+// withinWorkingHours reports whether the wall clock of t is within working hours, from start (included) to end (excluded).
+func withinWorkingHours(t time.Time, start, end time.Duration) bool {
+	clock := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second
+	if start < end {
+		return clock >= start && clock < end
+	}
+
+	return clock >= start || clock < end
+}
@@ -0,0 +1,91 @@
+package datetime
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestWorldClock tests the WorldClock function.
+func TestWorldClock(t *testing.T) {
+	// Monday 7 July 2025 at 16:30 in New York.
+	entries, err := WorldClock([]string{"America/New_York", "Europe/London", "Asia/Tokyo", "Pacific/Honolulu", "+05:30"}, "2025-07-07 16:30", "", "", nil, InputFormat{}, Format{Layout: "RFC3339"})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	expected := []WorldClockEntry{
+		{"America/New_York", "2025-07-07T16:30:00-04:00", "Monday", "EDT", "-04:00", 0, true},
+		{"Europe/London", "2025-07-07T21:30:00+01:00", "Monday", "BST", "+01:00", 0, false},
+		{"Asia/Tokyo", "2025-07-08T05:30:00+09:00", "Tuesday", "JST", "+09:00", 1, false},
+		{"Pacific/Honolulu", "2025-07-07T10:30:00-10:00", "Monday", "HST", "-10:00", 0, true},
+		{"UTC+05:30", "2025-07-08T02:00:00+05:30", "Tuesday", "UTC+05:30", "+05:30", 1, false},
+	}
+	if len(entries) != len(expected) {
+		t.Fatalf("expected %d entries, got %d", len(expected), len(entries))
+	}
+	for i := range expected {
+		if entries[i] != expected[i] {
+			t.Errorf("expected %+v, got %+v", expected[i], entries[i])
+		}
+	}
+}
+
+// TestWorldClockWorkingHours tests the working hours and weekend of WorldClock.
+func TestWorldClockWorkingHours(t *testing.T) {
+	tests := []struct {
+		name      string
+		time      string
+		workStart string
+		workEnd   string
+		weekend   []string
+		expected  bool
+	}{
+		{"start included", "2025-07-07 09:00", "", "", nil, true},
+		{"end excluded", "2025-07-07 17:00", "", "", nil, false},
+		{"custom hours", "2025-07-07 07:30", "7:00", "15:00", nil, true},
+		{"overnight", "2025-07-07 23:00", "22:00", "06:00", nil, true},
+		{"overnight morning", "2025-07-07 05:59", "22:00", "06:00", nil, true},
+		{"overnight day", "2025-07-07 12:00", "22:00", "06:00", nil, false},
+		{"weekend", "2025-07-06 10:00", "", "", nil, false},
+		{"custom weekend", "2025-07-04 10:00", "", "", []string{"Friday", "Saturday"}, false},
+		{"sunday business day", "2025-07-06 10:00", "", "", []string{"Friday", "Saturday"}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			entries, err := WorldClock([]string{"Europe/Paris"}, test.time, test.workStart, test.workEnd, test.weekend, InputFormat{}, Format{})
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+
+			if entries[0].WorkingHours != test.expected {
+				t.Errorf("expected working hours %t, got %t", test.expected, entries[0].WorkingHours)
+			}
+		})
+	}
+}
+
+// TestWorldClockInvalid tests that WorldClock rejects invalid arguments.
+func TestWorldClockInvalid(t *testing.T) {
+	tests := []struct {
+		name           string
+		timezones      []string
+		workStart      string
+		workEnd        string
+		expectedPrefix string
+	}{
+		{"no timezones", nil, "", "", "invalid_timezones:"},
+		{"invalid timezone", []string{"UTC", "Europe/Pari"}, "", "", "invalid_timezone:"},
+		{"invalid start", []string{"UTC"}, "9am", "", "invalid_working_hours:"},
+		{"empty hours", []string{"UTC"}, "09:00", "09:00", "invalid_working_hours:"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := WorldClock(test.timezones, "", test.workStart, test.workEnd, nil, InputFormat{}, Format{})
+			if err == nil || !strings.HasPrefix(err.Error(), test.expectedPrefix) {
+				t.Errorf("expected %s error, got %v", test.expectedPrefix, err)
+			}
+		})
+	}
+}
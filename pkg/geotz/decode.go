@@ -0,0 +1,182 @@
+package geotz
+
+import (
+	"errors"
+	"fmt"
+)
+
+// The embedded data is a protocol buffer message of the tzf project (CompressedTimezones), whose polygons are
+// encoded as polylines. Only the fields used here are decoded:
+//
+//	CompressedTimezones { 2: repeated CompressedTimezone timezones; 3: string version }
+//	CompressedTimezone  { 1: repeated CompressedPolygon data; 2: string name }
+//	CompressedPolygon   { 1: bytes points; 2: repeated CompressedPolygon holes }
+
+// errTruncated is returned when a message ends in the middle of a field.
+var errTruncated = errors.New("truncated message")
+
+// field is a decoded protocol buffer field. Only varint and length delimited fields are supported.
+type field struct {
+	number int
+	value  uint64
+	bytes  []byte
+}
+
+// decodeMessage calls fn for each field of a protocol buffer message.
+func decodeMessage(b []byte, fn func(field) error) error {
+	for len(b) > 0 {
+		key, n := decodeVarint(b)
+		if n == 0 {
+			return errTruncated
+		}
+		b = b[n:]
+
+		f := field{number: int(key >> 3)}
+		switch key & 7 {
+		case 0:
+			f.value, n = decodeVarint(b)
+			if n == 0 {
+				return errTruncated
+			}
+			b = b[n:]
+		case 2:
+			length, n := decodeVarint(b)
+			if n == 0 || uint64(len(b)-n) < length {
+				return errTruncated
+			}
+			f.bytes = b[n : n+int(length)]
+			b = b[n+int(length):]
+		default:
+			return fmt.Errorf("unsupported wire type %d", key&7)
+		}
+
+		if err := fn(f); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// decodeVarint decodes a varint, and returns its value and length, or a zero length when it is truncated.
+func decodeVarint(b []byte) (uint64, int) {
+	var v uint64
+	for i := 0; i < len(b) && i < 10; i++ {
+		v |= uint64(b[i]&0x7f) << (7 * i)
+		if b[i] < 0x80 {
+			return v, i + 1
+		}
+	}
+
+	return 0, 0
+}
+
+// decodeTimezones decodes the embedded timezones and their polygons.
+func decodeTimezones(b []byte) (names []string, polygons []polygon, version string, err error) {
+	err = decodeMessage(b, func(f field) error {
+		switch f.number {
+		case 2:
+			zone := len(names)
+			var name string
+			var zonePolygons []polygon
+			err := decodeMessage(f.bytes, func(f field) error {
+				switch f.number {
+				case 1:
+					p, err := decodePolygon(f.bytes)
+					if err != nil {
+						return err
+					}
+					zonePolygons = append(zonePolygons, p)
+				case 2:
+					name = string(f.bytes)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			for i := range zonePolygons {
+				zonePolygons[i].zone = zone
+			}
+			names = append(names, name)
+			polygons = append(polygons, zonePolygons...)
+		case 3:
+			version = string(f.bytes)
+		}
+		return nil
+	})
+
+	return names, polygons, version, err
+}
+
+// decodePolygon decodes a polygon and its holes.
+func decodePolygon(b []byte) (polygon, error) {
+	var p polygon
+	err := decodeMessage(b, func(f field) error {
+		switch f.number {
+		case 1:
+			r, err := decodePolyline(f.bytes)
+			if err != nil {
+				return err
+			}
+			p.exterior = r
+		case 2:
+			hole, err := decodePolygon(f.bytes)
+			if err != nil {
+				return err
+			}
+			p.holes = append(p.holes, hole.exterior)
+		}
+		return nil
+	})
+	if err != nil {
+		return p, err
+	}
+
+	p.bounds = p.exterior.bounds()
+	return p, nil
+}
+
+// decodePolyline decodes a ring encoded with the polyline algorithm, as longitude and latitude pairs
+// with a precision of 1e-5 degree.
+func decodePolyline(b []byte) (ring, error) {
+	var r ring
+	var x, y int32
+	for len(b) > 0 {
+		dx, n := decodePolylineValue(b)
+		if n == 0 {
+			return nil, errTruncated
+		}
+		b = b[n:]
+
+		dy, n := decodePolylineValue(b)
+		if n == 0 {
+			return nil, errTruncated
+		}
+		b = b[n:]
+
+		x, y = x+dx, y+dy
+		r = append(r, point{x, y})
+	}
+
+	return r, nil
+}
+
+// decodePolylineValue decodes a value encoded with the polyline algorithm, and returns its value and length,
+// or a zero length when it is truncated.
+func decodePolylineValue(b []byte) (int32, int) {
+	var v int32
+	for i := 0; i < len(b) && i < 7; i++ {
+		c := int32(b[i]) - 63
+		v |= (c & 0x1f) << (5 * i)
+		if c < 0x20 {
+			if v&1 != 0 {
+				return ^(v >> 1), i + 1
+			}
+			return v >> 1, i + 1
+		}
+	}
+
+	return 0, 0
+}
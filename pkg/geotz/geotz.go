@@ -0,0 +1,200 @@
+// Package geotz finds the timezone of geographic coordinates, offline.
+//
+// The timezone boundaries, including territorial waters and the Etc/GMT zones of international waters,
+// come from the timezone-boundary-builder project (https://github.com/evansiroky/timezone-boundary-builder),
+// simplified and compressed by the tzf project (https://github.com/ringsaturn/tzf-rel-lite). The data is
+// made available under the Open Database License (ODbL), and embedded in the binary. It is decoded on
+// first use, and indexed by a grid of one degree cells.
+package geotz
+
+import (
+	"fmt"
+	"math"
+	"sync"
+
+	tzfrellite "github.com/ringsaturn/tzf-rel-lite"
+)
+
+// scale is the number of coordinate units per degree.
+const scale = 1e5
+
+// point is a position, in 1e-5 degree units.
+type point struct {
+	// x is the longitude and y the latitude.
+	x, y int32
+}
+
+// bounds is a bounding box.
+type bounds struct {
+	min, max point
+}
+
+// contains returns whether a point is inside the bounding box.
+func (b bounds) contains(p point) bool {
+	return p.x >= b.min.x && p.x <= b.max.x && p.y >= b.min.y && p.y <= b.max.y
+}
+
+// ring is a closed line, the last point being joined to the first one.
+type ring []point
+
+// bounds returns the bounding box of the ring.
+func (r ring) bounds() bounds {
+	if len(r) == 0 {
+		return bounds{}
+	}
+
+	b := bounds{r[0], r[0]}
+	for _, p := range r[1:] {
+		b.min.x, b.min.y = min(b.min.x, p.x), min(b.min.y, p.y)
+		b.max.x, b.max.y = max(b.max.x, p.x), max(b.max.y, p.y)
+	}
+
+	return b
+}
+
+// contains returns whether a point is inside the ring, using the even-odd rule.
+func (r ring) contains(p point) bool {
+	inside := false
+	for i, j := 0, len(r)-1; i < len(r); j, i = i, i+1 {
+		a, b := r[i], r[j]
+		if (a.y > p.y) == (b.y > p.y) {
+			continue
+		}
+
+		// Longitude of the edge at the latitude of the point.
+		x := float64(a.x) + float64(p.y-a.y)*float64(b.x-a.x)/float64(b.y-a.y)
+		if float64(p.x) < x {
+			inside = !inside
+		}
+	}
+
+	return inside
+}
+
+// polygon is a part of a timezone.
+type polygon struct {
+	// zone is the index of the timezone name.
+	zone     int
+	exterior ring
+	holes    []ring
+	bounds   bounds
+}
+
+// contains returns whether a point is inside the polygon and outside its holes.
+func (p *polygon) contains(pt point) bool {
+	if !p.bounds.contains(pt) || !p.exterior.contains(pt) {
+		return false
+	}
+
+	for _, hole := range p.holes {
+		if hole.contains(pt) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// index is the decoded timezone data.
+type index struct {
+	// names lists the timezone names.
+	names []string
+	// polygons lists the polygons of all timezones.
+	polygons []polygon
+	// cells lists the polygons whose bounding box intersects each one degree cell, by latitude then longitude.
+	cells [180][360][]int32
+	// version is the version of the timezone boundaries (e.g., "2025b").
+	version string
+}
+
+// cell returns the cell of a point, clamped to the grid.
+func cell(p point) (int, int) {
+	row := min(max(int(math.Floor(float64(p.y)/scale))+90, 0), 179)
+	col := min(max(int(math.Floor(float64(p.x)/scale))+180, 0), 359)
+
+	return row, col
+}
+
+// load decodes and indexes the embedded timezone data.
+var load = sync.OnceValues(func() (*index, error) {
+	names, polygons, version, err := decodeTimezones(tzfrellite.LiteCompressData)
+	if err != nil {
+		return nil, fmt.Errorf("invalid embedded timezone boundaries: %w", err)
+	}
+
+	idx := &index{names: names, polygons: polygons, version: version}
+	for i, p := range polygons {
+		minRow, minCol := cell(p.bounds.min)
+		maxRow, maxCol := cell(p.bounds.max)
+		for row := minRow; row <= maxRow; row++ {
+			for col := minCol; col <= maxCol; col++ {
+				idx.cells[row][col] = append(idx.cells[row][col], int32(i))
+			}
+		}
+	}
+
+	return idx, nil
+})
+
+// ValidCoordinates returns whether a latitude and longitude are within [-90, 90] and [-180, 180] degrees.
+func ValidCoordinates(latitude, longitude float64) bool {
+	return latitude >= -90 && latitude <= 90 && longitude >= -180 && longitude <= 180
+}
+
+// Lookup returns the IANA timezones containing a position, given in decimal degrees. Most positions are in a single
+// timezone, international waters being covered by the Etc/GMT zones, but a few disputed areas are in several
+// timezones. Positions outside of all boundaries, such as the poles or gaps left by the simplification of the
+// boundaries, fall back to the nautical timezone of their longitude.
+func Lookup(latitude, longitude float64) ([]string, error) {
+	if !ValidCoordinates(latitude, longitude) {
+		return nil, fmt.Errorf("coordinates out of range: %g, %g", latitude, longitude)
+	}
+
+	idx, err := load()
+	if err != nil {
+		return nil, err
+	}
+
+	p := point{int32(math.Round(longitude * scale)), int32(math.Round(latitude * scale))}
+	row, col := cell(p)
+
+	var timezones []string
+	for _, i := range idx.cells[row][col] {
+		polygon := &idx.polygons[i]
+		if !polygon.contains(p) {
+			continue
+		}
+
+		name := idx.names[polygon.zone]
+		if len(timezones) == 0 || timezones[len(timezones)-1] != name {
+			timezones = append(timezones, name)
+		}
+	}
+
+	if len(timezones) == 0 {
+		timezones = append(timezones, nauticalTimezone(longitude))
+	}
+
+	return timezones, nil
+}
+
+// nauticalTimezone returns the Etc/GMT timezone of a longitude, each zone being 15 degrees wide.
+// The sign of Etc/GMT zones is inverted (e.g., "Etc/GMT+5" is five hours behind UTC).
+func nauticalTimezone(longitude float64) string {
+	offset := int(math.Round(longitude / 15))
+	if offset == 0 {
+		return "Etc/GMT"
+	}
+
+	return fmt.Sprintf("Etc/GMT%+d", -offset)
+}
+
+// Version returns the version of the embedded timezone boundaries (e.g., "2025b").
+func Version() (string, error) {
+	idx, err := load()
+	if err != nil {
+		return "", err
+	}
+
+	return idx.version, nil
+}
@@ -0,0 +1,88 @@
+package geotz
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+// TestLookup tests the timezones of known positions.
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		name      string
+		latitude  float64
+		longitude float64
+		expected  string
+	}{
+		{"Paris", 48.8566, 2.3522, "Europe/Paris"},
+		{"New York", 40.7128, -74.0060, "America/New_York"},
+		{"Lagos", 6.5244, 3.3792, "Africa/Lagos"},
+		{"Tokyo", 35.6762, 139.6503, "Asia/Tokyo"},
+		{"Sydney", -33.8688, 151.2093, "Australia/Sydney"},
+		{"Kolkata", 22.5726, 88.3639, "Asia/Kolkata"},
+		{"El Paso", 31.7619, -106.4850, "America/Denver"},
+		{"Phoenix", 33.4484, -112.0740, "America/Phoenix"},
+		{"Vatican", 41.9029, 12.4534, "Europe/Vatican"},
+		{"Atlantic Ocean", 30, -40, "Etc/GMT+3"},
+		{"Null Island", 0, 0, "Etc/GMT"},
+		{"North Pole", 90, 0, "Etc/GMT"},
+		{"Antimeridian", 0, 180, "Etc/GMT-12"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			timezones, err := Lookup(test.latitude, test.longitude)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+
+			if len(timezones) == 0 || timezones[0] != test.expected {
+				t.Errorf("expected %s, got %v", test.expected, timezones)
+			}
+		})
+	}
+}
+
+// TestLookupInvalid tests that Lookup rejects coordinates out of range.
+func TestLookupInvalid(t *testing.T) {
+	for _, c := range [][2]float64{{91, 0}, {-90.5, 0}, {0, 180.1}, {0, -181}} {
+		if _, err := Lookup(c[0], c[1]); err == nil {
+			t.Errorf("expected error for %v", c)
+		}
+	}
+}
+
+// TestData tests that the embedded timezone boundaries are decoded.
+func TestData(t *testing.T) {
+	idx, err := load()
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if idx.version == "" {
+		t.Error("missing version")
+	}
+	if !slices.Contains(idx.names, "Europe/Paris") || !slices.Contains(idx.names, "Etc/GMT+12") {
+		t.Errorf("missing timezones in %d names", len(idx.names))
+	}
+	for _, name := range idx.names {
+		if _, err := time.LoadLocation(name); err != nil {
+			t.Errorf("invalid timezone %s: %v", name, err)
+		}
+	}
+	for i, p := range idx.polygons {
+		if len(p.exterior) < 3 {
+			t.Errorf("polygon %d of %s has %d points", i, idx.names[p.zone], len(p.exterior))
+		}
+	}
+}
+
+// TestNauticalTimezone tests the nautical timezones of longitudes.
+func TestNauticalTimezone(t *testing.T) {
+	tests := map[float64]string{0: "Etc/GMT", 7.4: "Etc/GMT", 7.6: "Etc/GMT-1", -75: "Etc/GMT+5", 180: "Etc/GMT-12", -180: "Etc/GMT+12"}
+	for longitude, expected := range tests {
+		if got := nauticalTimezone(longitude); got != expected {
+			t.Errorf("expected %s for %g, got %s", expected, longitude, got)
+		}
+	}
+}
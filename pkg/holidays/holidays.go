@@ -0,0 +1,253 @@
+// Package holidays provides offline public holiday calendars.
+// Calendars are described by rules (fixed dates, nth weekday of a month, offsets from Easter)
+// which are embedded in the binary, and can be extended with custom files.
+package holidays
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// data contains the embedded holiday calendars, one file per country.
+//
+//go:embed data/*.json
+var data embed.FS
+
+var (
+	// calendars maps upper case country codes to their calendar.
+	calendars = map[string]*Calendar{}
+	// calendarsMutex protects calendars.
+	calendarsMutex sync.RWMutex
+)
+
+// init loads the embedded holiday calendars.
+func init() {
+	entries, err := data.ReadDir("data")
+	if err != nil {
+		panic(err)
+	}
+
+	for _, entry := range entries {
+		content, err := data.ReadFile(path.Join("data", entry.Name()))
+		if err != nil {
+			panic(err)
+		}
+
+		err = load(content)
+		if err != nil {
+			panic(fmt.Errorf("invalid embedded holiday file %s: %w", entry.Name(), err))
+		}
+	}
+}
+
+// file is the format of a holiday file.
+type file struct {
+	// Country is the country code of the calendar (e.g., "US").
+	Country string `json:"country"`
+	// Name is the name of the country.
+	Name string `json:"name"`
+	// Holidays lists the holiday rules.
+	Holidays []Rule `json:"holidays"`
+}
+
+// LoadFile loads a custom holiday file from disk.
+// If a calendar already exists for the country of the file, the rules are added to it.
+func LoadFile(filePath string) error {
+	content, err := os.ReadFile(filePath) // nolint:gosec
+	if err != nil {
+		return err
+	}
+
+	err = load(content)
+	if err != nil {
+		return fmt.Errorf("invalid holiday file %s: %w", filePath, err)
+	}
+
+	return nil
+}
+
+// load parses a holiday file and registers its rules.
+func load(content []byte) error {
+	var f file
+	err := json.Unmarshal(content, &f)
+	if err != nil {
+		return err
+	}
+
+	if f.Country == "" {
+		return fmt.Errorf("missing country")
+	}
+
+	for i := range f.Holidays {
+		err := f.Holidays[i].validate()
+		if err != nil {
+			return fmt.Errorf("holiday %d (%s): %w", i, f.Holidays[i].Name, err)
+		}
+	}
+
+	calendarsMutex.Lock()
+	defer calendarsMutex.Unlock()
+
+	code := strings.ToUpper(f.Country)
+	c, ok := calendars[code]
+	if !ok {
+		c = &Calendar{Country: code, Name: f.Name}
+		calendars[code] = c
+	}
+	c.addRules(f.Holidays)
+
+	return nil
+}
+
+// Lookup returns the calendar of the given country code (case insensitive).
+func Lookup(country string) (*Calendar, error) {
+	calendarsMutex.RLock()
+	defer calendarsMutex.RUnlock()
+
+	c, ok := calendars[strings.ToUpper(strings.TrimSpace(country))]
+	if !ok {
+		return nil, fmt.Errorf("unknown holiday calendar: %s", country)
+	}
+
+	return c, nil
+}
+
+// Countries returns the sorted list of available country codes.
+func Countries() []string {
+	calendarsMutex.RLock()
+	defer calendarsMutex.RUnlock()
+
+	return slices.Sorted(maps.Keys(calendars))
+}
+
+// Holiday is a holiday occurring on a given date.
+type Holiday struct {
+	Date Date   `json:"date"`
+	Name string `json:"name"`
+	// Observed is set when the date is the day off observed in place of a holiday falling on a weekend.
+	Observed bool `json:"observed,omitempty"`
+}
+
+// Calendar is the holiday calendar of a country.
+type Calendar struct {
+	// Country is the upper case country code.
+	Country string `json:"country"`
+	// Name is the name of the country.
+	Name string `json:"name"`
+
+	mutex sync.Mutex
+	rules []Rule
+	// cache stores the computed holidays by year, up to maxCachedYears years.
+	cache map[int][]Holiday
+}
+
+// maxCachedYears is the maximum number of years of holidays cached by a calendar. The cache is emptied when full,
+// so that it does not grow with every year requested.
+const maxCachedYears = 200
+
+// addRules adds rules to the calendar and resets its cache.
+func (c *Calendar) addRules(rules []Rule) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.rules = append(c.rules, rules...)
+	c.cache = nil
+}
+
+// Holidays returns the holidays, including observed days, which fall in the given year, sorted by date.
+func (c *Calendar) Holidays(year int) []Holiday {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if h, ok := c.cache[year]; ok {
+		return slices.Clone(h)
+	}
+
+	// Observed days can move across the year boundary (e.g., January 1st observed on December 31st).
+	var result []Holiday
+	for y := year - 1; y <= year+1; y++ {
+		for _, h := range c.compute(y) {
+			if h.Date.Year == year {
+				result = append(result, h)
+			}
+		}
+	}
+
+	if c.cache == nil {
+		c.cache = map[int][]Holiday{}
+	}
+	if len(c.cache) >= maxCachedYears {
+		clear(c.cache)
+	}
+	c.cache[year] = result
+
+	return slices.Clone(result)
+}
+
+// On returns the holidays, including observed days, which fall on the date of t, in the location of t.
+func (c *Calendar) On(t time.Time) []Holiday {
+	date := DateOf(t)
+
+	var result []Holiday
+	for _, h := range c.Holidays(date.Year) {
+		if h.Date == date {
+			result = append(result, h)
+		}
+	}
+
+	return result
+}
+
+// compute returns the holidays defined by the rules for the given year, with their observed days.
+func (c *Calendar) compute(year int) []Holiday {
+	type occurrence struct {
+		Holiday
+		rule Rule
+	}
+
+	var occurrences []occurrence
+	taken := map[Date]bool{}
+	for _, rule := range c.rules {
+		date, ok := rule.occurrence(year)
+		if !ok {
+			continue
+		}
+		occurrences = append(occurrences, occurrence{Holiday{Date: date, Name: rule.Name}, rule})
+		taken[date] = true
+	}
+	sort.SliceStable(occurrences, func(i, j int) bool {
+		return occurrences[i].Date.Before(occurrences[j].Date)
+	})
+
+	// Add observed days in chronological order, so that substitute days do not collide.
+	holidays := make([]Holiday, 0, len(occurrences))
+	for _, o := range occurrences {
+		holidays = append(holidays, o.Holiday)
+
+		date, ok := o.rule.observe(o.Date, taken)
+		if !ok {
+			continue
+		}
+		holidays = append(holidays, Holiday{Date: date, Name: o.Name, Observed: true})
+		taken[date] = true
+	}
+	sortHolidays(holidays)
+
+	return holidays
+}
+
+// sortHolidays sorts holidays by date, keeping the order of the rules for a same date.
+func sortHolidays(holidays []Holiday) {
+	sort.SliceStable(holidays, func(i, j int) bool {
+		return holidays[i].Date.Before(holidays[j].Date)
+	})
+}
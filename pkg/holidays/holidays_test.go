@@ -0,0 +1,231 @@
+package holidays
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// TestEaster tests the easter function against known dates.
+func TestEaster(t *testing.T) {
+	tests := map[int]string{
+		2000: "2000-04-23",
+		2019: "2019-04-21",
+		2024: "2024-03-31",
+		2025: "2025-04-20",
+		2038: "2038-04-25",
+	}
+
+	for year, expected := range tests {
+		date := DateOf(easter(year)).String()
+		if date != expected {
+			t.Errorf("expected easter %d on %s, got %s", year, expected, date)
+		}
+	}
+}
+
+// TestRuleOccurrence tests the occurrence method of Rule.
+func TestRuleOccurrence(t *testing.T) {
+	offset := func(days int) *int { return &days }
+
+	tests := []struct {
+		name         string
+		rule         Rule
+		year         int
+		expectedDate string
+	}{
+		{"fixed", Rule{Name: "Christmas", Month: 12, Day: 25}, 2025, "2025-12-25"},
+		{"easter", Rule{Name: "Good Friday", Easter: offset(-2)}, 2025, "2025-04-18"},
+		{"nth weekday", Rule{Name: "Thanksgiving", Month: 11, Weekday: "Thursday", Nth: 4}, 2025, "2025-11-27"},
+		{"last weekday", Rule{Name: "Memorial Day", Month: 5, Weekday: "Monday", Nth: -1}, 2025, "2025-05-26"},
+		{"weekday before day", Rule{Name: "Victoria Day", Month: 5, Day: 24, Weekday: "Monday", Nth: -1}, 2025, "2025-05-19"},
+		{"weekday after day", Rule{Name: "Midsummer", Month: 6, Day: 20, Weekday: "Saturday", Nth: 1}, 2025, "2025-06-21"},
+		{"single date", Rule{Name: "Reformation", Date: "2017-10-31"}, 2017, "2017-10-31"},
+		{"single date other year", Rule{Name: "Reformation", Date: "2017-10-31"}, 2018, ""},
+		{"before from", Rule{Name: "Juneteenth", Month: 6, Day: 19, From: 2021}, 2020, ""},
+		{"after until", Rule{Name: "Queen's Day", Month: 4, Day: 30, Until: 2013}, 2014, ""},
+		{"leap day", Rule{Name: "Leap day", Month: 2, Day: 29}, 2025, ""},
+		{"missing fifth weekday", Rule{Name: "Fifth Monday", Month: 2, Weekday: "Monday", Nth: 5}, 2025, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.rule.validate()
+			if err != nil {
+				t.Errorf("unexpected error %v", err)
+				return
+			}
+
+			date, ok := test.rule.occurrence(test.year)
+			if !ok {
+				if test.expectedDate != "" {
+					t.Errorf("expected date %s, got none", test.expectedDate)
+				}
+				return
+			}
+
+			if date.String() != test.expectedDate {
+				t.Errorf("expected date %q, got %q", test.expectedDate, date)
+			}
+		})
+	}
+}
+
+// TestCalendarHolidays tests observed days of the embedded calendars.
+func TestCalendarHolidays(t *testing.T) {
+	tests := []struct {
+		name     string
+		country  string
+		date     string
+		expected []Holiday
+	}{
+		{
+			"nearest weekday before",
+			"US",
+			"2021-12-31",
+			[]Holiday{{Date: Date{2021, time.December, 31}, Name: "New Year's Day", Observed: true}},
+		},
+		{
+			"nearest weekday after",
+			"US",
+			"2021-07-05",
+			[]Holiday{{Date: Date{2021, time.July, 5}, Name: "Independence Day", Observed: true}},
+		},
+		{
+			"substitute",
+			"GB",
+			"2021-12-27",
+			[]Holiday{{Date: Date{2021, time.December, 27}, Name: "Christmas Day", Observed: true}},
+		},
+		{
+			"substitute after substitute",
+			"GB",
+			"2021-12-28",
+			[]Holiday{{Date: Date{2021, time.December, 28}, Name: "Boxing Day", Observed: true}},
+		},
+		{
+			"substitute after holiday",
+			"GB",
+			"2022-12-27",
+			[]Holiday{{Date: Date{2022, time.December, 27}, Name: "Christmas Day", Observed: true}},
+		},
+		{
+			"not observed",
+			"FR",
+			"2024-07-15",
+			nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c, err := Lookup(test.country)
+			if err != nil {
+				t.Errorf("unexpected error %v", err)
+				return
+			}
+
+			date, err := time.Parse(time.DateOnly, test.date)
+			if err != nil {
+				t.Errorf("unexpected error %v", err)
+				return
+			}
+
+			holidays := c.On(date)
+			if len(holidays) != len(test.expected) {
+				t.Errorf("expected holidays %v, got %v", test.expected, holidays)
+				return
+			}
+
+			for i := range holidays {
+				if holidays[i] != test.expected[i] {
+					t.Errorf("expected holiday %v, got %v", test.expected[i], holidays[i])
+				}
+			}
+		})
+	}
+}
+
+// TestCalendarCache tests that the cache of a calendar is bounded, and that holidays are computed again once evicted.
+func TestCalendarCache(t *testing.T) {
+	c, err := Lookup("US")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	expected := c.Holidays(2025)
+	for year := 3000; year < 3000+2*maxCachedYears; year++ {
+		c.Holidays(year)
+	}
+
+	c.mutex.Lock()
+	size := len(c.cache)
+	c.mutex.Unlock()
+	if size > maxCachedYears {
+		t.Errorf("expected at most %d cached years, got %d", maxCachedYears, size)
+	}
+
+	if holidays := c.Holidays(2025); len(holidays) != len(expected) || holidays[0] != expected[0] {
+		t.Errorf("expected holidays %v, got %v", expected, holidays)
+	}
+}
+
+// TestLoadFile tests loading a custom holiday file.
+func TestLoadFile(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "custom.json")
+	content := `{
+  "country": "TEST",
+  "name": "Test",
+  "holidays": [
+    {"name": "Founders Day", "month": 3, "day": 14},
+    {"name": "Company Retreat", "date": "2025-09-12"}
+  ]
+}`
+	err := os.WriteFile(filePath, []byte(content), 0600)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	err = LoadFile(filePath)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	c, err := Lookup("test")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	holidays := c.Holidays(2025)
+	if len(holidays) != 2 {
+		t.Fatalf("expected 2 holidays, got %v", holidays)
+	}
+
+	if holidays[0].Date.String() != "2025-03-14" || holidays[1].Date.String() != "2025-09-12" {
+		t.Errorf("unexpected holidays %v", holidays)
+	}
+}
+
+// TestLoadFileInvalid tests that invalid holiday files are rejected.
+func TestLoadFileInvalid(t *testing.T) {
+	tests := map[string]string{
+		"missing country": `{"holidays": []}`,
+		"missing name":    `{"country": "X", "holidays": [{"month": 1, "day": 1}]}`,
+		"invalid day":     `{"country": "X", "holidays": [{"name": "A", "month": 2, "day": 30}]}`,
+		"invalid weekday": `{"country": "X", "holidays": [{"name": "A", "month": 2, "weekday": "Funday", "nth": 1}]}`,
+		"invalid nth":     `{"country": "X", "holidays": [{"name": "A", "month": 2, "weekday": "Monday"}]}`,
+		"invalid date":    `{"country": "X", "holidays": [{"name": "A", "date": "2025/01/01"}]}`,
+		"invalid policy":  `{"country": "X", "holidays": [{"name": "A", "month": 1, "day": 1, "observed": "never"}]}`,
+		"invalid json":    `{`,
+	}
+
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := load([]byte(content))
+			if err == nil {
+				t.Errorf("expected error")
+			}
+		})
+	}
+}
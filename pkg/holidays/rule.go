@@ -0,0 +1,250 @@
+package holidays
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Observance policies, which define the day off observed when a holiday falls on a weekend.
+const (
+	// ObservedNearestWeekday moves Saturday holidays to Friday and Sunday holidays to Monday.
+	ObservedNearestWeekday = "nearest_weekday"
+	// ObservedFollowingMonday moves Saturday and Sunday holidays to the following Monday.
+	ObservedFollowingMonday = "following_monday"
+	// ObservedSundayToMonday moves Sunday holidays to Monday, Saturday holidays are not moved.
+	ObservedSundayToMonday = "sunday_to_monday"
+	// ObservedSundayToSaturday moves Sunday holidays to the previous Saturday.
+	ObservedSundayToSaturday = "sunday_to_saturday"
+	// ObservedSubstitute moves weekend holidays to the next weekday which is not already a holiday.
+	ObservedSubstitute = "substitute"
+)
+
+// Rule defines when a holiday occurs. The kind of rule is determined by the fields which are set:
+//   - Date: a single occurrence on a given date (e.g., "2025-12-24").
+//   - Easter: an offset in days from Easter Sunday (e.g., -2 for Good Friday).
+//   - Weekday and Nth: the nth weekday of Month (e.g., 4th Thursday of November). A negative Nth counts
+//     from the end of the month (e.g., -1 for the last Monday of May). If Day is set, weekdays are counted
+//     from that day of the month, forward for a positive Nth and backward for a negative one.
+//   - Month and Day: a fixed date every year (e.g., December 25th).
+type Rule struct {
+	Name    string `json:"name"`
+	Date    string `json:"date,omitempty"`
+	Easter  *int   `json:"easter,omitempty"`
+	Month   int    `json:"month,omitempty"`
+	Day     int    `json:"day,omitempty"`
+	Weekday string `json:"weekday,omitempty"`
+	Nth     int    `json:"nth,omitempty"`
+	// Observed is the observance policy applied when the holiday falls on a weekend, see the Observed constants.
+	Observed string `json:"observed,omitempty"`
+	// From and Until restrict the rule to a range of years (inclusive).
+	From  int `json:"from,omitempty"`
+	Until int `json:"until,omitempty"`
+
+	// date and weekday are the parsed Date and Weekday.
+	date    Date
+	weekday time.Weekday
+}
+
+// validate checks the rule and parses its fields.
+func (r *Rule) validate() error {
+	if r.Name == "" {
+		return fmt.Errorf("missing name")
+	}
+
+	switch {
+	case r.Date != "":
+		t, err := time.Parse(time.DateOnly, r.Date)
+		if err != nil {
+			return fmt.Errorf("invalid date %q: expected format YYYY-MM-DD", r.Date)
+		}
+		r.date = DateOf(t)
+	case r.Easter != nil:
+	case r.Weekday != "":
+		weekday, err := ParseWeekday(r.Weekday)
+		if err != nil {
+			return err
+		}
+		r.weekday = weekday
+		if r.Nth == 0 || r.Nth < -5 || r.Nth > 5 {
+			return fmt.Errorf("invalid nth %d: expected 1 to 5, or -1 to -5", r.Nth)
+		}
+		if r.Month < 1 || r.Month > 12 {
+			return fmt.Errorf("invalid month %d", r.Month)
+		}
+		if r.Day < 0 || r.Day > 31 {
+			return fmt.Errorf("invalid day %d", r.Day)
+		}
+	case r.Month != 0:
+		if r.Month < 1 || r.Month > 12 {
+			return fmt.Errorf("invalid month %d", r.Month)
+		}
+		if r.Day < 1 || r.Day > DaysIn(2000, time.Month(r.Month)) {
+			return fmt.Errorf("invalid day %d for month %d", r.Day, r.Month)
+		}
+	default:
+		return fmt.Errorf("missing date, easter, weekday or month")
+	}
+
+	switch r.Observed {
+	case "", ObservedNearestWeekday, ObservedFollowingMonday, ObservedSundayToMonday, ObservedSundayToSaturday, ObservedSubstitute:
+	default:
+		return fmt.Errorf("invalid observed policy %q", r.Observed)
+	}
+
+	return nil
+}
+
+// occurrence returns the date of the holiday in the given year, if it occurs that year.
+func (r Rule) occurrence(year int) (Date, bool) {
+	if (r.From != 0 && year < r.From) || (r.Until != 0 && year > r.Until) {
+		return Date{}, false
+	}
+
+	switch {
+	case r.Date != "":
+		return r.date, r.date.Year == year
+	case r.Easter != nil:
+		return DateOf(easter(year).AddDate(0, 0, *r.Easter)), true
+	case r.Weekday != "":
+		return r.nthWeekday(year)
+	default:
+		// February 29th only occurs on leap years.
+		if r.Day > DaysIn(year, time.Month(r.Month)) {
+			return Date{}, false
+		}
+		return Date{year, time.Month(r.Month), r.Day}, true
+	}
+}
+
+// nthWeekday returns the date of the nth weekday rule in the given year.
+func (r Rule) nthWeekday(year int) (Date, bool) {
+	month := time.Month(r.Month)
+
+	if r.Nth > 0 {
+		day := max(r.Day, 1)
+		t := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		t = t.AddDate(0, 0, (int(r.weekday)-int(t.Weekday())+7)%7+7*(r.Nth-1))
+		return DateOf(t), r.Day != 0 || t.Month() == month
+	}
+
+	day := r.Day
+	if day == 0 {
+		day = DaysIn(year, month)
+	}
+	t := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	t = t.AddDate(0, 0, -((int(t.Weekday())-int(r.weekday)+7)%7)+7*(r.Nth+1))
+	return DateOf(t), r.Day != 0 || t.Month() == month
+}
+
+// observe returns the observed date of a holiday occurring on date, according to the observance policy.
+// It returns false if the holiday is observed on its own date.
+// taken contains the dates which are already holidays, for the substitute policy.
+func (r Rule) observe(date Date, taken map[Date]bool) (Date, bool) {
+	t := date.Time()
+	weekday := t.Weekday()
+
+	var shift int
+	switch r.Observed {
+	case ObservedNearestWeekday:
+		shift = map[time.Weekday]int{time.Saturday: -1, time.Sunday: 1}[weekday]
+	case ObservedFollowingMonday:
+		shift = map[time.Weekday]int{time.Saturday: 2, time.Sunday: 1}[weekday]
+	case ObservedSundayToMonday:
+		shift = map[time.Weekday]int{time.Sunday: 1}[weekday]
+	case ObservedSundayToSaturday:
+		shift = map[time.Weekday]int{time.Sunday: -1}[weekday]
+	case ObservedSubstitute:
+		if weekday != time.Saturday && weekday != time.Sunday {
+			return Date{}, false
+		}
+		for {
+			shift++
+			next := t.AddDate(0, 0, shift)
+			if next.Weekday() != time.Saturday && next.Weekday() != time.Sunday && !taken[DateOf(next)] {
+				break
+			}
+		}
+	}
+
+	if shift == 0 {
+		return Date{}, false
+	}
+
+	return DateOf(t.AddDate(0, 0, shift)), true
+}
+
+// easter returns the date of Easter Sunday in the Gregorian calendar,
+// using the anonymous Gregorian algorithm (Meeus/Jones/Butcher).
+func easter(year int) time.Time {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
+
+// ParseWeekday parses a weekday name, either in full or abbreviated to its first three letters
+// (e.g., "Monday", "mon"), compared case insensitively.
+func ParseWeekday(name string) (time.Weekday, error) {
+	lower := strings.ToLower(strings.TrimSpace(name))
+	if len(lower) >= 3 {
+		for day := time.Sunday; day <= time.Saturday; day++ {
+			full := strings.ToLower(day.String())
+			if lower == full || lower == full[:3] {
+				return day, nil
+			}
+		}
+	}
+
+	return 0, fmt.Errorf("invalid weekday %q", name)
+}
+
+// DaysIn returns the number of days in the given month.
+func DaysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// Date is a calendar date, without time and location.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// DateOf returns the date of t, in the location of t.
+func DateOf(t time.Time) Date {
+	year, month, day := t.Date()
+	return Date{year, month, day}
+}
+
+// Time returns the date at midnight UTC.
+func (d Date) Time() time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, time.UTC)
+}
+
+// Before reports whether d is before other.
+func (d Date) Before(other Date) bool {
+	return d.Time().Before(other.Time())
+}
+
+// String returns the date in the YYYY-MM-DD format.
+func (d Date) String() string {
+	return d.Time().Format(time.DateOnly)
+}
+
+// MarshalText implements encoding.TextMarshaler, using the YYYY-MM-DD format.
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
@@ -0,0 +1,208 @@
+// Package locale provides offline month and weekday names and default date and time patterns
+// for a few languages, in the spirit of the CLDR. The locales are embedded in the binary.
+package locale
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+	"time"
+)
+
+// data contains the embedded locales, one file per language.
+//
+//go:embed data/*.json
+var data embed.FS
+
+// Locale describes how dates and times are written in a language.
+type Locale struct {
+	// Tag is the language tag of the locale (e.g., "fr").
+	Tag string `json:"tag"`
+	// Name is the English name of the language.
+	Name string `json:"name"`
+	// Months lists the month names, from January.
+	Months [12]string `json:"months"`
+	// ShortMonths lists the abbreviated month names, from January.
+	ShortMonths [12]string `json:"short_months"`
+	// Weekdays lists the weekday names, from Sunday.
+	Weekdays [7]string `json:"weekdays"`
+	// ShortWeekdays lists the abbreviated weekday names, from Sunday.
+	ShortWeekdays [7]string `json:"short_weekdays"`
+	// AM and PM are the day period markers.
+	AM string `json:"am"`
+	PM string `json:"pm"`
+
+	// The patterns are Go layouts, whose English names are replaced by the names of the locale.
+	DateFull   string `json:"date_full"`
+	DateLong   string `json:"date_long"`
+	DateMedium string `json:"date_medium"`
+	DateShort  string `json:"date_short"`
+	TimeMedium string `json:"time_medium"`
+	TimeShort  string `json:"time_short"`
+	// DateTime combines a date pattern ({1}) and a time pattern ({0}).
+	DateTime string `json:"date_time"`
+}
+
+// locales maps language tags to their locale.
+var locales = map[string]*Locale{}
+
+// init loads the embedded locales.
+func init() {
+	entries, err := data.ReadDir("data")
+	if err != nil {
+		panic(err)
+	}
+
+	for _, entry := range entries {
+		content, err := data.ReadFile(path.Join("data", entry.Name()))
+		if err != nil {
+			panic(err)
+		}
+
+		var l Locale
+		err = json.Unmarshal(content, &l)
+		if err != nil {
+			panic(fmt.Errorf("invalid embedded locale %s: %w", entry.Name(), err))
+		}
+
+		locales[l.Tag] = &l
+	}
+}
+
+// Lookup returns the locale of a language tag (e.g., "fr", "de-AT", "pt_BR"), compared case insensitively.
+// Regional tags fall back to their language.
+func Lookup(tag string) (*Locale, bool) {
+	tag = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"))
+	if l, ok := locales[tag]; ok {
+		return l, true
+	}
+
+	language, _, _ := strings.Cut(tag, "-")
+	l, ok := locales[language]
+
+	return l, ok
+}
+
+// Tags returns the sorted language tags of the available locales.
+func Tags() []string { return slices.Sorted(maps.Keys(locales)) }
+
+// styles lists the names of the locale patterns.
+var styles = []string{
+	"DateFull", "DateLong", "DateMedium", "DateShort",
+	"TimeMedium", "TimeShort",
+	"DateTimeFull", "DateTimeLong", "DateTimeMedium", "DateTimeShort",
+}
+
+// Styles returns the names of the locale patterns, which may be used in place of a layout.
+func Styles() []string { return slices.Clone(styles) }
+
+// Layout returns the Go layout of a pattern name (e.g., "DateLong").
+// The date and time patterns are combined with the medium time, or the short time for "DateTimeShort".
+func (l *Locale) Layout(style string) (string, bool) {
+	switch style {
+	case "DateFull":
+		return l.DateFull, true
+	case "DateLong":
+		return l.DateLong, true
+	case "DateMedium":
+		return l.DateMedium, true
+	case "DateShort":
+		return l.DateShort, true
+	case "TimeMedium":
+		return l.TimeMedium, true
+	case "TimeShort":
+		return l.TimeShort, true
+	case "DateTimeFull":
+		return l.dateTime(l.DateFull, l.TimeMedium), true
+	case "DateTimeLong":
+		return l.dateTime(l.DateLong, l.TimeMedium), true
+	case "DateTimeMedium":
+		return l.dateTime(l.DateMedium, l.TimeMedium), true
+	case "DateTimeShort":
+		return l.dateTime(l.DateShort, l.TimeShort), true
+	}
+
+	return "", false
+}
+
+// dateTime combines a date and a time layout.
+func (l *Locale) dateTime(date, clock string) string {
+	return strings.NewReplacer("{1}", date, "{0}", clock).Replace(l.DateTime)
+}
+
+// Format formats t like time.Format, but writes month names, weekday names and day periods in the language of the locale.
+func (l *Locale) Format(t time.Time, layout string) string {
+	var b strings.Builder
+	for layout != "" {
+		i, name := nextName(layout)
+		if i < 0 {
+			b.WriteString(t.Format(layout))
+			break
+		}
+
+		b.WriteString(t.Format(layout[:i]))
+		b.WriteString(l.name(t, name))
+		layout = layout[i+len(name):]
+	}
+
+	return b.String()
+}
+
+// name returns the localized value of a name element of a layout.
+func (l *Locale) name(t time.Time, name string) string {
+	switch name {
+	case "January":
+		return l.Months[t.Month()-1]
+	case "Jan":
+		return l.ShortMonths[t.Month()-1]
+	case "Monday":
+		return l.Weekdays[t.Weekday()]
+	case "Mon":
+		return l.ShortWeekdays[t.Weekday()]
+	case "PM", "pm":
+		period := l.AM
+		if t.Hour() >= 12 {
+			period = l.PM
+		}
+		if name == "pm" {
+			period = strings.ToLower(period)
+		}
+		return period
+	}
+
+	return name
+}
+
+// nextName returns the position of the first name element (month, weekday or day period) of a layout,
+// recognized as time.Format does, or -1.
+func nextName(layout string) (int, string) {
+	for i := 0; i < len(layout); i++ {
+		rest := layout[i:]
+		switch {
+		case strings.HasPrefix(rest, "January"):
+			return i, "January"
+		case strings.HasPrefix(rest, "Jan") && !startsWithLower(rest[3:]):
+			return i, "Jan"
+		case strings.HasPrefix(rest, "Monday"):
+			return i, "Monday"
+		case strings.HasPrefix(rest, "Mon") && !startsWithLower(rest[3:]):
+			return i, "Mon"
+		case strings.HasPrefix(rest, "PM"):
+			return i, "PM"
+		case strings.HasPrefix(rest, "pm"):
+			return i, "pm"
+		}
+	}
+
+	return -1, ""
+}
+
+// startsWithLower reports whether s starts with a lower case letter, in which case time.Format
+// does not recognize the preceding "Jan" or "Mon" (e.g., "Monat").
+func startsWithLower(s string) bool {
+	return s != "" && 'a' <= s[0] && s[0] <= 'z'
+}
@@ -0,0 +1,116 @@
+package locale
+
+import (
+	"testing"
+	"time"
+)
+
+// TestData tests that the embedded locales are complete and their patterns are valid.
+func TestData(t *testing.T) {
+	if _, ok := locales["en"]; !ok {
+		t.Fatal("missing en locale")
+	}
+
+	reference := time.Date(2025, time.July, 8, 9, 30, 45, 0, time.UTC)
+	for tag, l := range locales {
+		if l.Name == "" || l.AM == "" || l.PM == "" {
+			t.Errorf("missing name or day periods for locale %s", tag)
+		}
+		for i := range 12 {
+			if l.Months[i] == "" || l.ShortMonths[i] == "" {
+				t.Errorf("missing month %d for locale %s", i+1, tag)
+			}
+		}
+		for i := range 7 {
+			if l.Weekdays[i] == "" || l.ShortWeekdays[i] == "" {
+				t.Errorf("missing weekday %d for locale %s", i, tag)
+			}
+		}
+
+		for _, style := range styles {
+			layout, ok := l.Layout(style)
+			if !ok || layout == "" {
+				t.Errorf("missing %s pattern for locale %s", style, tag)
+				continue
+			}
+
+			// The patterns must print the date and time of the reference.
+			output := l.Format(reference, layout)
+			if output == layout {
+				t.Errorf("%s pattern %q of locale %s is not a layout", style, layout, tag)
+			}
+		}
+	}
+}
+
+// TestLookup tests the language tags accepted by Lookup.
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		tag         string
+		expectedTag string
+	}{
+		{"fr", "fr"},
+		{"FR", "fr"},
+		{"de-AT", "de"},
+		{"pt_BR", "pt"},
+		{" ja ", "ja"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.tag, func(t *testing.T) {
+			l, ok := Lookup(test.tag)
+			if !ok || l.Tag != test.expectedTag {
+				t.Errorf("expected %s, got %+v", test.expectedTag, l)
+			}
+		})
+	}
+
+	for _, tag := range []string{"", "xx", "klingon"} {
+		if _, ok := Lookup(tag); ok {
+			t.Errorf("expected no locale for %q", tag)
+		}
+	}
+}
+
+// TestFormat tests the localized names and patterns.
+func TestFormat(t *testing.T) {
+	afternoon := time.Date(2025, time.February, 3, 15, 4, 5, 0, time.UTC)
+	morning := time.Date(2025, time.December, 7, 9, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		tag      string
+		t        time.Time
+		layout   string
+		expected string
+	}{
+		{"en", afternoon, "Monday, January 2, 2006 3:04 PM MST", "Monday, February 3, 2025 3:04 PM UTC"},
+		{"fr", afternoon, "Monday 2 January 2006", "lundi 3 février 2025"},
+		{"fr", morning, "Mon 2 Jan 2006", "dim. 7 déc. 2025"},
+		{"de", afternoon, "DateTimeFull", "Montag, 3. Februar 2025, 15:04:05"},
+		{"de", morning, "DateMedium", "07.12.2025"},
+		{"ja", afternoon, "DateTimeLong", "2025年2月3日 15:04:05"},
+		{"ja", morning, "DateFull", "2025年12月7日日曜日"},
+		{"ja", afternoon, "PM3:04", "午後3:04"},
+		{"ko", morning, "TimeShort", "오전 9:30"},
+		{"es", afternoon, "DateLong", "3 de febrero de 2025"},
+		{"es", morning, "3:04 pm", "9:30 a. m."},
+		{"ru", afternoon, "DateLong", "3 февраля 2025 г."},
+		{"zh", afternoon, "DateFull", "2025年2月3日星期一"},
+		// Names followed by a lower case letter are not layout elements.
+		{"de", afternoon, "Monat: 01", "Monat: 02"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.tag+" "+test.layout, func(t *testing.T) {
+			l, _ := Lookup(test.tag)
+			layout, ok := l.Layout(test.layout)
+			if !ok {
+				layout = test.layout
+			}
+
+			if output := l.Format(test.t, layout); output != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, output)
+			}
+		})
+	}
+}
@@ -19,6 +19,10 @@ var formatDescription = `The output time format, which can be a predefined forma
 
 ` + fmt.Sprintf("`%s`", strings.Join(datetime.GetFormats(), "`, `")) + `
 
+The Unix, UnixMilli, UnixMicro and UnixNano formats are the Unix timestamp in seconds, milliseconds, microseconds and nanoseconds (e.g., "1751968800123" for "UnixMilli").
+
+The Date*, Time* and DateTime* formats are the date and time patterns of the 'locale' (e.g., "DateLong" is "January 2, 2006" in English and "2 janvier 2006" in French).
+
 ## Custom Format
 
 A custom format can be built using the following components. Each component shows an example of how a part of the reference time is formatted. Only these values are recognized. Any text in the layout string that is not a recognized component will be treated as a literal.
@@ -33,6 +37,8 @@ A custom format can be built using the following components. Each component show
 - Second: "5", "05"
 - AM/PM mark: "PM"
 
+Month names, weekday names and AM/PM marks are written in the language of the 'locale'.
+
 ### Numeric Time Zone Offsets
 
 - "-0700"     (±hhmm)
@@ -55,13 +61,45 @@ The formats __2 and 002 are space-padded and zero-padded three-character day of
 ### Fractional Seconds
 
 A comma or decimal point followed by one or more zeros represents a fractional second, printed to the given number of decimal places. A comma or decimal point followed by one or more nines represents a fractional second with trailing zeros removed.
-For example, "15:04:05.000" formats or parses with millisecond precision.`
+For example, "15:04:05.000" formats or parses with millisecond precision.
+
+## strftime Format
+
+With the 'strftime' format style, or the "strftime:" prefix (e.g., "strftime:%Y-%m-%d %H:%M"), the format uses strftime specifiers:
+
+- Year: "%Y" (2006), "%y" (06), "%C" (20), "%G" and "%g" (ISO 8601 week-numbering year)
+- Month: "%m" (01), "%B" (January), "%b" or "%h" (Jan)
+- Week: "%V" (ISO 8601 week, 01-53), "%U" (week starting on Sunday, 00-53), "%W" (week starting on Monday, 00-53)
+- Day of the week: "%A" (Monday), "%a" (Mon), "%u" (1-7, Monday is 1), "%w" (0-6, Sunday is 0)
+- Day of the month: "%d" (02), "%e" ( 2); day of the year: "%j" (002)
+- Hour: "%H" (15), "%I" (03), "%k" (15, space padded), "%l" ( 3, space padded); AM/PM mark: "%p" (PM), "%P" (pm)
+- Minute: "%M"; second: "%S"; fractional second: "%f" (microseconds), "%N" (nanoseconds)
+- Time zone: "%z" (-0700), "%:z" (-07:00), "%Z" (MST); Unix timestamp: "%s"
+- Shorthands: "%F" (%Y-%m-%d), "%T" (%H:%M:%S), "%D" (%m/%d/%y), "%R" (%H:%M), "%r" (%I:%M:%S %p), "%c", "%x" and "%X" (date and time, date, time, in the patterns of the 'locale')
+- Literals: "%%" (%), "%n" (newline), "%t" (tab)
+
+Numeric specifiers accept a padding flag: "%-d" (no padding), "%_d" (spaces), "%0e" (zeros).
+
+## ICU and Moment.js Formats
+
+With the 'icu' format style, or the "icu:" prefix, the format is an ICU (LDML) pattern, as used by Java and Unicode libraries (e.g., "yyyy-MM-dd'T'HH:mm:ss.SSSXXX"). Letters are fields (y, Y, u, G, Q, M, L, w, d, D, E, e, c, a, h, H, K, k, m, s, S, z, Z, X, x), and literal text is quoted with apostrophes. "Y" is the ISO 8601 week-numbering year, "w" the ISO 8601 week, and "e" the ISO weekday number.
+
+With the 'moment' format style, or the "moment:" prefix, the format is a Moment.js format (e.g., "YYYY-MM-DD HH:mm", "dddd, MMMM Do YYYY"), including the localized "LT", "LTS", "L", "LL", "LLL" and "LLLL" formats, and literal text is escaped with brackets (e.g., "[at] h:mm A"). Weeks and weekday numbers follow ISO 8601 ("W", "GGGG", "E"), and ordinals ("Do") are written in English.
+
+Tokens which cannot be formatted, such as locale weeks or narrow names, are rejected with an error.`
 
 // durationDescription explains the format for duration strings used in MCP tools.
 const durationDescription = `The duration to add or subtract. Use a negative value to subtract.
+Supported units: years ("y", "year"), months ("mo", "month"), weeks ("w", "week"), days ("d", "day"), hours ("h", "hour"), minutes ("m", "min", "minute"), seconds ("s", "sec", "second"), "ms", "us", "ns".
+Years, months, weeks and days are calendar units: they keep the local time of day in the output timezone, even across daylight saving time changes. Adding months or years never overflows into the next month: the day is clamped to the last day of the target month (e.g., January 31 + 1 month is February 28).
 Examples:
 - "1h2m3s" to add 1 hour, 2 minutes, and 3 seconds.
-- "-1h" to subtract 1 hour.`
+- "-1h" to subtract 1 hour.
+- "1d" or "1 day" to get the same time on the next day.
+- "1 month" to get the same day next month.
+- "1y2mo3d4h" to add 1 year, 2 months, 3 days and 4 hours.
+- "-2 weeks" to subtract 2 weeks.
+- "P1DT2H" or "PT90M" as ISO 8601 durations (PnYnMnWnDTnHnMnS, the smallest component may be fractional, e.g., "P1.5D").`
 
 // relativeTimeDescription provides examples of natural language expressions for relative time.
 const relativeTimeDescription = `A relative time expression in natural language.
@@ -82,11 +120,123 @@ Examples:
 - "December 25th at 7:30am"
 - "10am"
 - "10:05pm"
-- "10:05:22pm"`
+- "10:05:22pm"
+Other languages are understood with the 'language' parameter, e.g.:
+- "hier à 10h", "lundi prochain", "il y a 3 jours" (fr)
+- "nächsten Montag um 9 Uhr", "vor zwei Stunden" (de)
+- "mañana a las 10", "la semana pasada", "hace 2 horas" (es)`
 
 // compareDescription explains the output of the compare_time tool.
 const compareDescription = `Compares two times. Returns -1 if the first time is before the second, 0 if they are equal, and 1 if the first time is after the second.`
 
+// differenceDescription explains the output of the time_difference tool.
+const differenceDescription = `Computes the signed difference between two times (time_b - time_a). The difference is positive when time_b is after time_a.
+Returns a JSON object with:
+- "duration": the difference as a duration string (e.g., "26h3m0s").
+- "total_seconds": the difference in seconds.
+- "calendar": the difference broken down into years, months, days, hours, minutes and seconds, computed on the wall clock of time_a.
+- "iso8601": the calendar breakdown as an ISO 8601 duration (e.g., "P1DT2H3M"), which can be used as an add_time duration.`
+
+// businessDaysDescription explains the business_days tool.
+const businessDaysDescription = `Business day arithmetic, skipping weekend days and, when a country is set, its public holidays.
+Operations:
+- "add": adds a number of business days to a time and returns the resulting time. A negative number moves backward. With 0 days, a time falling on a weekend is moved to the next business day.
+- "count": counts the business days between time (included) and end_time (excluded). The result is negative if end_time is before time.
+Days are determined in the given timezone.`
+
+// isHolidayDescription explains the is_holiday tool.
+const isHolidayDescription = `Checks whether the day of a time is a public holiday in a country.
+Returns a JSON object with the checked "date", the "country", "is_holiday" and the list of "holidays" falling on that day. Days off observed in place of a holiday falling on a weekend are included with "observed" set to true.`
+
+// listHolidaysDescription explains the list_holidays tool.
+const listHolidaysDescription = `Lists the public holidays of a country for a year, as a JSON array of objects with "date", "name" and "observed".
+Days off observed in place of a holiday falling on a weekend are included with "observed" set to true.`
+
+// cronDescription explains the cron_next tool.
+const cronDescription = `Returns the next (or previous) occurrences of a cron expression, as a JSON array of times.
+The expression is evaluated on the wall clock of the given timezone. When clocks are moved forward for daylight saving time, occurrences falling in the skipped hour run once at the end of the gap. When clocks are moved back, occurrences in the repeated hour run once, at their first occurrence.`
+
+// cronExpressionDescription explains the cron expression syntax.
+const cronExpressionDescription = `The cron expression, with 5 fields (minute, hour, day of month, month, day of week) or 6 fields (with a leading seconds field).
+Each field accepts "*", values, ranges ("1-5"), steps ("*/15", "0-30/5") and lists ("1,15,30"). Months and days of week accept names ("JAN", "MON"). When both the day of month and day of week are restricted, a day matches if either matches.
+Macros: "@yearly", "@annually", "@monthly", "@weekly", "@daily", "@midnight", "@hourly".
+Examples:
+- "*/15 * * * *" every 15 minutes.
+- "0 9 * * MON-FRI" at 9:00 on weekdays.
+- "0 0 1 */3 *" at midnight on the first day of every quarter.`
+
+// recurrenceDescription explains the expand_recurrence tool.
+const recurrenceDescription = `Expands an iCalendar (RFC 5545) recurrence rule into its occurrences, as a JSON array of times.
+The rule is evaluated on the wall clock of the given timezone, starting at dtstart. Occurrences are returned within the window, up to the limit. Excluded dates count toward COUNT but are not returned.
+When clocks are moved forward for daylight saving time, an occurrence falling in the skipped hour is shifted by the length of the gap (e.g., 02:30 becomes 03:30). When clocks are moved back, an occurrence in the repeated hour happens once, at its first occurrence.`
+
+// rruleDescription explains the recurrence rule syntax.
+const rruleDescription = `The recurrence rule, with an optional "RRULE:" prefix, optionally followed by EXDATE lines (e.g., "EXDATE;TZID=Europe/Paris:20250715T090000").
+Supported parts: FREQ (YEARLY, MONTHLY, WEEKLY, DAILY, HOURLY, MINUTELY, SECONDLY), INTERVAL, COUNT, UNTIL, BYMONTH, BYMONTHDAY, BYYEARDAY, BYDAY (with ordinals for MONTHLY and YEARLY, e.g., "-1FR"), BYHOUR, BYMINUTE, BYSECOND, BYSETPOS and WKST.
+Examples:
+- "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10" every Monday and Wednesday, 10 times.
+- "FREQ=MONTHLY;BYDAY=-1FR" the last Friday of every month.
+- "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1" the last weekday of every month.
+- "FREQ=YEARLY;BYMONTH=11;BYDAY=4TH" the fourth Thursday of November.`
+
+// timezoneInfoDescription explains the timezone_info tool.
+const timezoneInfoDescription = `Returns information about a timezone at a given time, as a JSON object with:
+- "abbreviation", "offset" (e.g., "+02:00") and "offset_seconds" of the zone in use, and "is_dst" when daylight saving time is in effect.
+- "previous_transition" and "next_transition": the surrounding changes of offset or abbreviation, with their "time", the "shift" of the clocks (e.g., "1h0m0s" when clocks are moved forward), the offsets and abbreviations before and after, and "is_dst" after the transition. They are null when the timezone has no such transition.`
+
+// listTimezonesDescription explains the list_timezones tool.
+const listTimezonesDescription = `Lists the available IANA timezone names, as a JSON array of objects with the timezone "name", and the "abbreviation" and "offset" in use at the reference time.
+Filters can be combined, and all timezones are listed when none is set. Use this tool to find a valid name for the timezone parameters of the other tools.`
+
+// findTimezoneDescription explains the find_timezone tool.
+const findTimezoneDescription = `Finds the timezone of a place (city or country) from an offline gazetteer, as a JSON array of candidates, the best match first.
+Each candidate has the place "name", its "kind" ("city" or "country"), "country" code, "country_name", IANA "timezone", "population" for cities, and "match" ("exact", "alias", "prefix" or "fuzzy").
+A country with several timezones is returned once per timezone, the most populated first.`
+
+// timezoneForCoordinatesDescription explains the timezone_for_coordinates tool.
+const timezoneForCoordinatesDescription = `Finds the timezone of geographic coordinates (e.g., a GPS position) from offline timezone boundaries, including territorial waters and the nautical timezones (Etc/GMT) of international waters.
+Returns a JSON object with the "latitude", "longitude", IANA "timezone" and its state at the reference time, as returned by the 'timezone_info' tool. In disputed areas, "other_timezones" lists the other timezones claiming the position.
+Timezone parameters of the other tools also accept coordinates (e.g., '48.8566,2.3522').`
+
+// worldClockDescription explains the world_clock tool.
+const worldClockDescription = `Returns the local time of several timezones at once, as a JSON array with one object per timezone, in the requested order.
+Each object has the "timezone", local "time", "weekday", "abbreviation" and "offset" in use, the "day_difference" between its local date and the local date of the first timezone (e.g., 1 when it is already the next day), and "working_hours", set when the local time is within working hours on a day which is not a weekend day.`
+
+// findMeetingSlotsDescription explains the find_meeting_slots tool.
+const findMeetingSlotsDescription = `Finds meeting slots across the working hours of participants in different timezones, as a JSON array of slots, the best first.
+Slots are ranked by the number of participants in working hours, then chronologically, and never overlap. Slots outside the working hours of all participants are not returned. Working hours are evaluated on the wall clock of each participant, following daylight saving time changes.
+Each slot has its "start" and "end" in the timezone of the first participant, the number of participants "in_hours", and the "participants" with their "name", local "start" and "end", and "in_hours".`
+
+// periodBoundsDescription explains the period_bounds tool.
+const periodBoundsDescription = `Returns the bounds of the calendar period (minute, hour, day, week, ISO week, month, quarter or year) containing a time, evaluated on the wall clock of the given timezone.
+Returns a JSON object with the "start" (first instant) and "end" (last instant) of the period, and "next_start", the start of the following period, to be used as an excluded bound.
+Use the offset to get a neighboring period (e.g., unit 'quarter' with offset -1 for the last quarter).`
+
+// roundTimeDescription explains the round_time tool.
+const roundTimeDescription = `Rounds a time to a multiple of an interval (e.g., '15m', '1h', '1d') on the wall clock of the given timezone, so that intervals are aligned on local hours and midnights whatever the UTC offset (e.g., +05:45 in Asia/Kathmandu).
+Intervals are counted from local midnight, and intervals longer than a day from January 1st, 1970; weeks are rejected, use the 'period_bounds' tool for weeks and months.
+When the result falls in an hour skipped by a daylight saving time change, the end of the gap is returned.`
+
+// dateInfoDescription explains the date_info tool.
+const dateInfoDescription = `Returns calendar facts about a time, computed in the given timezone, as a JSON object with:
+- "date", "year", "month", "day", "month_name", "weekday" and "weekday_number" (ISO 8601, 1 for Monday to 7 for Sunday).
+- "iso_year", "iso_week" and "iso_week_date" (e.g., "2025-W28-3"): the ISO 8601 week-numbering year may differ from the calendar year at the start and end of the year.
+- "day_of_year", "quarter", "days_in_month", "days_in_year", "is_leap_year" and "unix_timestamp" (seconds).
+Use this tool instead of computing week numbers or days of the year.`
+
+// humanizeDescription explains the humanize_time tool.
+const humanizeDescription = `Renders a time relative to a reference time as human text, such as "3 hours ago", "in 2 days" or "in 2 days, 3 hours". This is the reverse of the 'relative_time' tool.
+The difference is expressed in the largest unit allowed by the thresholds (by default, 45 seconds or more are minutes, 45 minutes or more are hours, 22 hours or more are days, 26 days or more are months and 11 months or more are years), then in up to "precision" units, the smallest being no smaller than the granularity.
+The smallest unit shown is rounded to the nearest, units which are zero are omitted, and a difference rounding to zero is "just now".`
+
+// parseTimeDescription explains the parse_time tool.
+const parseTimeDescription = `Parses a time and reports how it was read, as a JSON object with:
+- "time": the parsed instant, in RFC 3339 by default.
+- "layout": the Go layout the time was read with (e.g., "01/02/2006 15:04"), or its Unix timestamp format (e.g., "UnixMilli").
+- "timezone" and "timezone_present": the timezone of the time, and whether the input carries its own timezone or was interpreted in the given timezone.
+- "warnings": the assumptions made to read the time, such as an ambiguous day and month order (ambiguous_date_order), a local time skipped (nonexistent_time) or repeated (repeated_time) by a daylight saving time change, or a timezone abbreviation read as UTC (unknown_timezone_abbreviation).
+Use this tool to check how a time will be understood by the other tools before using it.`
+
 // RegisterHandlers registers the time and date MCP tools with the provided MCP server.
 //
 // Parameters:
@@ -100,7 +250,12 @@ func RegisterHandlers(s *server.MCPServer) {
 			mcp.Description(formatDescription),
 			mcp.DefaultString(datetime.GetDefaultFormat()),
 		),
+		localeProperty,
+		formatStyleProperty,
 		timezoneProperty,
+		mcp.WithString("location",
+			mcp.Description("A place name (e.g., 'Lagos', 'Portland, US'), used instead of the timezone. The timezone of the best match is used, see the 'find_timezone' tool."),
+		),
 
 		mcp.WithReadOnlyHintAnnotation(true),
 		mcp.WithDestructiveHintAnnotation(false),
@@ -112,15 +267,19 @@ func RegisterHandlers(s *server.MCPServer) {
 	convertTimezone := mcp.NewTool("convert_timezone",
 		mcp.WithDescription("Converts a time from one timezone to another."),
 		mcp.WithString("input_timezone",
-			mcp.Description("The timezone of the input time, in IANA format (e.g., 'America/New_York'). If the input time string contains a timezone, it will take precedence."),
+			mcp.Description("The timezone of the input time, "+timezoneForms+". If the input time string contains a timezone, it will take precedence."),
 			mcp.DefaultString(datetime.GetDefaultTimezone()),
 		),
 		mcp.WithString("output_timezone",
-			mcp.Description("The target timezone for the output, in IANA format (e.g., 'America/New_York')."),
+			mcp.Description("The target timezone for the output, "+timezoneForms+"."),
 			mcp.DefaultString(datetime.GetDefaultTimezone()),
 		),
 		timeProperty,
+		inputFormatProperty,
+		dateOrderProperty,
 		formatProperty,
+		localeProperty,
+		formatStyleProperty,
 
 		mcp.WithReadOnlyHintAnnotation(true),
 		mcp.WithDestructiveHintAnnotation(false),
@@ -136,8 +295,12 @@ func RegisterHandlers(s *server.MCPServer) {
 			mcp.Required(),
 		),
 		timeProperty,
+		inputFormatProperty,
+		dateOrderProperty,
 		timezoneProperty,
 		formatProperty,
+		localeProperty,
+		formatStyleProperty,
 
 		mcp.WithReadOnlyHintAnnotation(true),
 		mcp.WithDestructiveHintAnnotation(false),
@@ -152,9 +315,16 @@ func RegisterHandlers(s *server.MCPServer) {
 			mcp.Description(relativeTimeDescription),
 			mcp.Required(),
 		),
+		mcp.WithString("language",
+			mcp.Description("The language of the expression, as a language code. Available languages: "+strings.Join(datetime.GetRelativeLanguages(), ", ")+". Expressions which are not understood are parsed as English. Defaults to English."),
+		),
 		timeProperty,
+		inputFormatProperty,
+		dateOrderProperty,
 		timezoneProperty,
 		formatProperty,
+		localeProperty,
+		formatStyleProperty,
 
 		mcp.WithReadOnlyHintAnnotation(true),
 		mcp.WithDestructiveHintAnnotation(false),
@@ -170,15 +340,17 @@ func RegisterHandlers(s *server.MCPServer) {
 			mcp.Required(),
 		),
 		mcp.WithString("time_a_timezone",
-			mcp.Description("Timezone form time_a, in IANA format (e.g., 'America/New_York')."),
+			mcp.Description("Timezone for time_a, "+timezoneForms+"."),
 			mcp.DefaultString(datetime.GetDefaultTimezone()),
 		),
 		mcp.WithString("time_b",
 			mcp.Description("The second time to compare."),
 			mcp.Required(),
 		),
+		inputFormatProperty,
+		dateOrderProperty,
 		mcp.WithString("time_b_timezone",
-			mcp.Description("Timezone for time_b, in IANA format (e.g., 'America/New_York')."),
+			mcp.Description("Timezone for time_b, "+timezoneForms+"."),
 			mcp.DefaultString(datetime.GetDefaultTimezone()),
 		),
 
@@ -188,4 +360,536 @@ func RegisterHandlers(s *server.MCPServer) {
 		mcp.WithOpenWorldHintAnnotation(false),
 	)
 	s.AddTool(compareTime, CompareTime)
+
+	timeDifference := mcp.NewTool("time_difference",
+		mcp.WithDescription(differenceDescription),
+		mcp.WithString("time_a",
+			mcp.Description("The start time."),
+			mcp.Required(),
+		),
+		mcp.WithString("time_a_timezone",
+			mcp.Description("Timezone for time_a, "+timezoneForms+"."),
+			mcp.DefaultString(datetime.GetDefaultTimezone()),
+		),
+		mcp.WithString("time_b",
+			mcp.Description("The end time."),
+			mcp.Required(),
+		),
+		inputFormatProperty,
+		dateOrderProperty,
+		mcp.WithString("time_b_timezone",
+			mcp.Description("Timezone for time_b, "+timezoneForms+"."),
+			mcp.DefaultString(datetime.GetDefaultTimezone()),
+		),
+
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithOpenWorldHintAnnotation(false),
+	)
+	s.AddTool(timeDifference, TimeDifference)
+
+	businessDays := mcp.NewTool("business_days",
+		mcp.WithDescription(businessDaysDescription),
+		mcp.WithString("operation",
+			mcp.Description("The operation to perform: add business days to a time, or count business days between two times."),
+			mcp.Enum("add", "count"),
+			mcp.DefaultString("add"),
+		),
+		mcp.WithNumber("days",
+			mcp.Description("The number of business days to add, for the add operation, between -100000 and 100000. Use a negative value to subtract."),
+		),
+		mcp.WithString("end_time",
+			mcp.Description("The end time (excluded), for the count operation."),
+		),
+		mcp.WithArray("weekend",
+			mcp.Description("The days of the week which are not business days (e.g., ['Friday', 'Saturday'])."),
+			mcp.WithStringItems(),
+			mcp.DefaultArray(datetime.GetDefaultWeekend()),
+		),
+		countryProperty,
+		mcp.WithString("timezone",
+			mcp.Description("The timezone in which days are counted and the output is returned, "+timezoneForms+". It is also used for input times without timezone."),
+			mcp.DefaultString(datetime.GetDefaultTimezone()),
+		),
+		timeProperty,
+		inputFormatProperty,
+		dateOrderProperty,
+		formatProperty,
+		localeProperty,
+		formatStyleProperty,
+
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithOpenWorldHintAnnotation(false),
+	)
+	s.AddTool(businessDays, BusinessDays)
+
+	// Holiday calendars can be loaded from files at startup, list them when registering the tools.
+	countryDescription := fmt.Sprintf("The country code of the holiday calendar. Available: %s.", strings.Join(datetime.GetHolidayCountries(), ", "))
+
+	isHoliday := mcp.NewTool("is_holiday",
+		mcp.WithDescription(isHolidayDescription),
+		mcp.WithString("country",
+			mcp.Description(countryDescription),
+			mcp.Required(),
+		),
+		mcp.WithString("timezone",
+			mcp.Description("The timezone in which the day is determined, "+timezoneForms+". It is also used for input times without timezone."),
+			mcp.DefaultString(datetime.GetDefaultTimezone()),
+		),
+		timeProperty,
+		inputFormatProperty,
+		dateOrderProperty,
+
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithOpenWorldHintAnnotation(false),
+	)
+	s.AddTool(isHoliday, IsHoliday)
+
+	listHolidays := mcp.NewTool("list_holidays",
+		mcp.WithDescription(listHolidaysDescription),
+		mcp.WithString("country",
+			mcp.Description(countryDescription),
+			mcp.Required(),
+		),
+		mcp.WithNumber("year",
+			mcp.Description("The year to list holidays for. Defaults to the current year."),
+		),
+
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithOpenWorldHintAnnotation(false),
+	)
+	s.AddTool(listHolidays, ListHolidays)
+
+	cronNext := mcp.NewTool("cron_next",
+		mcp.WithDescription(cronDescription),
+		mcp.WithString("expression",
+			mcp.Description(cronExpressionDescription),
+			mcp.Required(),
+		),
+		mcp.WithString("direction",
+			mcp.Description("Whether to return the occurrences after or before the reference time."),
+			mcp.Enum("next", "previous"),
+			mcp.DefaultString("next"),
+		),
+		mcp.WithNumber("count",
+			mcp.Description("The number of occurrences to return."),
+			mcp.DefaultNumber(1),
+			mcp.Min(1),
+			mcp.Max(100),
+		),
+		mcp.WithString("time",
+			mcp.Description("The reference time, in any format. Defaults to the current time."),
+		),
+		inputFormatProperty,
+		dateOrderProperty,
+		mcp.WithString("timezone",
+			mcp.Description("The timezone in which the expression is evaluated and the output is returned, "+timezoneForms+". It is also used for input times without timezone."),
+			mcp.DefaultString(datetime.GetDefaultTimezone()),
+		),
+		formatProperty,
+		localeProperty,
+		formatStyleProperty,
+
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithOpenWorldHintAnnotation(false),
+	)
+	s.AddTool(cronNext, CronNext)
+
+	expandRecurrence := mcp.NewTool("expand_recurrence",
+		mcp.WithDescription(recurrenceDescription),
+		mcp.WithString("dtstart",
+			mcp.Description("The start of the recurrence (DTSTART), in any format. It is the first occurrence if it matches the rule."),
+			mcp.Required(),
+		),
+		mcp.WithString("rrule",
+			mcp.Description(rruleDescription),
+			mcp.Required(),
+		),
+		mcp.WithArray("exdates",
+			mcp.Description("The occurrences to exclude (EXDATE), in any format."),
+			mcp.WithStringItems(),
+		),
+		mcp.WithString("window_start",
+			mcp.Description("The start of the window (included). Defaults to dtstart."),
+		),
+		mcp.WithString("window_end",
+			mcp.Description("The end of the window (included). Defaults to no end."),
+		),
+		inputFormatProperty,
+		dateOrderProperty,
+		mcp.WithNumber("limit",
+			mcp.Description("The maximum number of occurrences to return."),
+			mcp.DefaultNumber(100),
+			mcp.Min(1),
+			mcp.Max(1000),
+		),
+		mcp.WithString("timezone",
+			mcp.Description("The timezone in which the rule is evaluated and the output is returned, "+timezoneForms+". It is also used for input times without timezone."),
+			mcp.DefaultString(datetime.GetDefaultTimezone()),
+		),
+		formatProperty,
+		localeProperty,
+		formatStyleProperty,
+
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithOpenWorldHintAnnotation(false),
+	)
+	s.AddTool(expandRecurrence, ExpandRecurrence)
+
+	timezoneInfo := mcp.NewTool("timezone_info",
+		mcp.WithDescription(timezoneInfoDescription),
+		mcp.WithString("timezone",
+			mcp.Description("The timezone to describe, "+timezoneForms+". It is also used for input times without timezone."),
+			mcp.Required(),
+		),
+		timeProperty,
+		inputFormatProperty,
+		dateOrderProperty,
+		formatProperty,
+		localeProperty,
+		formatStyleProperty,
+
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithOpenWorldHintAnnotation(false),
+	)
+	s.AddTool(timezoneInfo, TimezoneInfo)
+
+	listTimezones := mcp.NewTool("list_timezones",
+		mcp.WithDescription(listTimezonesDescription),
+		mcp.WithString("region",
+			mcp.Description("Only list timezones starting with this region prefix, case insensitive (e.g., 'America/', 'Europe/')."),
+		),
+		mcp.WithString("contains",
+			mcp.Description("Only list timezones containing this text, case insensitive (e.g., 'york'). Use underscores instead of spaces."),
+		),
+		mcp.WithString("offset",
+			mcp.Description("Only list timezones using this UTC offset at the reference time (e.g., '+05:30', '-3', 'UTC+1')."),
+		),
+		mcp.WithString("time",
+			mcp.Description("The reference time for the offsets, in any format. Defaults to the current time."),
+		),
+		inputFormatProperty,
+		dateOrderProperty,
+
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithOpenWorldHintAnnotation(false),
+	)
+	s.AddTool(listTimezones, ListTimezones)
+
+	findTimezone := mcp.NewTool("find_timezone",
+		mcp.WithDescription(findTimezoneDescription),
+		mcp.WithString("query",
+			mcp.Description("The city or country name, optionally followed by a country name or code after a comma (e.g., 'Lagos', 'Portland, US', 'Japan'). Other qualifiers, such as states, are not supported: 'Paris, TX' matches no place. Accents, case and punctuation are ignored."),
+			mcp.Required(),
+		),
+		mcp.WithNumber("limit",
+			mcp.Description("The maximum number of candidates to return."),
+			mcp.DefaultNumber(5),
+			mcp.Min(1),
+			mcp.Max(50),
+		),
+
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithOpenWorldHintAnnotation(false),
+	)
+	s.AddTool(findTimezone, FindTimezone)
+
+	timezoneForCoordinates := mcp.NewTool("timezone_for_coordinates",
+		mcp.WithDescription(timezoneForCoordinatesDescription),
+		mcp.WithNumber("latitude",
+			mcp.Description("The latitude, in decimal degrees (e.g., 48.8566)."),
+			mcp.Required(),
+			mcp.Min(-90),
+			mcp.Max(90),
+		),
+		mcp.WithNumber("longitude",
+			mcp.Description("The longitude, in decimal degrees (e.g., 2.3522)."),
+			mcp.Required(),
+			mcp.Min(-180),
+			mcp.Max(180),
+		),
+		mcp.WithString("time",
+			mcp.Description("The reference time, in any format. Input times without timezone are interpreted in the timezone of the coordinates. Defaults to the current time."),
+		),
+		inputFormatProperty,
+		dateOrderProperty,
+		formatProperty,
+		localeProperty,
+		formatStyleProperty,
+
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithOpenWorldHintAnnotation(false),
+	)
+	s.AddTool(timezoneForCoordinates, TimezoneForCoordinates)
+
+	workStart, workEnd := datetime.GetDefaultWorkingHours()
+	worldClock := mcp.NewTool("world_clock",
+		mcp.WithDescription(worldClockDescription),
+		mcp.WithArray("timezones",
+			mcp.Description("The timezones to show, the first one being the reference for day differences, as IANA names (e.g., 'America/New_York'), UTC offsets (e.g., '+05:30'), abbreviations (e.g., 'PST') or latitude,longitude coordinates (e.g., '48.8566,2.3522')."),
+			mcp.WithStringItems(),
+			mcp.Required(),
+		),
+		mcp.WithString("time",
+			mcp.Description("The reference time, in any format. Input times without timezone are interpreted in the first timezone. Defaults to the current time."),
+		),
+		inputFormatProperty,
+		dateOrderProperty,
+		mcp.WithString("work_start",
+			mcp.Description("The start of working hours (included), as HH:MM."),
+			mcp.DefaultString(workStart),
+		),
+		mcp.WithString("work_end",
+			mcp.Description("The end of working hours (excluded), as HH:MM. Working hours span midnight when the end is before the start (e.g., '22:00' to '06:00')."),
+			mcp.DefaultString(workEnd),
+		),
+		mcp.WithArray("weekend",
+			mcp.Description("The days of the week which are not working days (e.g., ['Friday', 'Saturday'])."),
+			mcp.WithStringItems(),
+			mcp.DefaultArray(datetime.GetDefaultWeekend()),
+		),
+		formatProperty,
+		localeProperty,
+		formatStyleProperty,
+
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithOpenWorldHintAnnotation(false),
+	)
+	s.AddTool(worldClock, WorldClock)
+
+	findMeetingSlots := mcp.NewTool("find_meeting_slots",
+		mcp.WithDescription(findMeetingSlotsDescription),
+		mcp.WithArray("participants",
+			mcp.Description("The participants, with their timezone and working hours. Input times without timezone are interpreted in the timezone of the first participant."),
+			mcp.Items(map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"name": map[string]any{
+						"type":        "string",
+						"description": "The name of the participant. Defaults to the timezone.",
+					},
+					"timezone": map[string]any{
+						"type":        "string",
+						"description": "The timezone of the participant, " + timezoneForms + ".",
+					},
+					"work_start": map[string]any{
+						"type":        "string",
+						"description": "The start of working hours (included), as HH:MM. Defaults to '09:00'.",
+					},
+					"work_end": map[string]any{
+						"type":        "string",
+						"description": "The end of working hours (excluded), as HH:MM. Defaults to '17:00'. Working hours span midnight when the end is before the start.",
+					},
+					"weekend": map[string]any{
+						"type":        "array",
+						"items":       map[string]any{"type": "string"},
+						"description": "The days of the week which are not working days. Defaults to ['Saturday', 'Sunday'].",
+					},
+					"country": map[string]any{
+						"type":        "string",
+						"description": "The country code of a holiday calendar (e.g., 'US'), whose public holidays are not working days. See the 'list_holidays' tool for the available countries.",
+					},
+				},
+				"required": []string{"timezone"},
+			}),
+			mcp.Required(),
+		),
+		mcp.WithString("duration",
+			mcp.Description("The duration of the meeting (e.g., '30m', '1h30m', 'PT45M')."),
+			mcp.Required(),
+		),
+		mcp.WithString("start",
+			mcp.Description("The start of the search range, in any format. Defaults to the current time."),
+		),
+		mcp.WithString("end",
+			mcp.Description("The end of the search range (excluded), in any format. Defaults to one week after the start, and can be at most 31 days after it."),
+		),
+		inputFormatProperty,
+		dateOrderProperty,
+		mcp.WithNumber("limit",
+			mcp.Description("The maximum number of slots to return."),
+			mcp.DefaultNumber(10),
+			mcp.Min(1),
+			mcp.Max(100),
+		),
+		formatProperty,
+		localeProperty,
+		formatStyleProperty,
+
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithOpenWorldHintAnnotation(false),
+	)
+	s.AddTool(findMeetingSlots, FindMeetingSlots)
+
+	periodBounds := mcp.NewTool("period_bounds",
+		mcp.WithDescription(periodBoundsDescription),
+		mcp.WithString("unit",
+			mcp.Description("The calendar period. Weeks start on week_start, while ISO weeks (isoweek) always start on Monday."),
+			mcp.Enum(datetime.GetPeriodUnits()...),
+			mcp.Required(),
+		),
+		mcp.WithNumber("offset",
+			mcp.Description("The number of periods to move by (e.g., -1 for the previous period, 1 for the next one), up to 10000 years."),
+			mcp.DefaultNumber(0),
+		),
+		mcp.WithString("week_start",
+			mcp.Description("The first day of the week, for the week unit (e.g., 'Sunday')."),
+			mcp.DefaultString("Monday"),
+		),
+		mcp.WithString("timezone",
+			mcp.Description("The timezone in which periods are evaluated and the output is returned, "+timezoneForms+". It is also used for input times without timezone."),
+			mcp.DefaultString(datetime.GetDefaultTimezone()),
+		),
+		timeProperty,
+		inputFormatProperty,
+		dateOrderProperty,
+		formatProperty,
+		localeProperty,
+		formatStyleProperty,
+
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithOpenWorldHintAnnotation(false),
+	)
+	s.AddTool(periodBounds, PeriodBounds)
+
+	roundTime := mcp.NewTool("round_time",
+		mcp.WithDescription(roundTimeDescription),
+		mcp.WithString("interval",
+			mcp.Description("The interval to round to (e.g., '15m', '1h', '1h30m', '1d')."),
+			mcp.Required(),
+		),
+		mcp.WithString("mode",
+			mcp.Description("How to round: to the nearest multiple (halfway rounding up), down (floor) or up (ceil)."),
+			mcp.Enum(datetime.GetRoundModes()...),
+			mcp.DefaultString("round"),
+		),
+		mcp.WithString("timezone",
+			mcp.Description("The timezone in which the time is rounded and the output is returned, "+timezoneForms+". It is also used for input times without timezone."),
+			mcp.DefaultString(datetime.GetDefaultTimezone()),
+		),
+		timeProperty,
+		inputFormatProperty,
+		dateOrderProperty,
+		formatProperty,
+		localeProperty,
+		formatStyleProperty,
+
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithOpenWorldHintAnnotation(false),
+	)
+	s.AddTool(roundTime, RoundTime)
+
+	dateInfo := mcp.NewTool("date_info",
+		mcp.WithDescription(dateInfoDescription),
+		mcp.WithString("timezone",
+			mcp.Description("The timezone in which the date is determined and the time is returned, "+timezoneForms+". It is also used for input times without timezone."),
+			mcp.DefaultString(datetime.GetDefaultTimezone()),
+		),
+		timeProperty,
+		inputFormatProperty,
+		dateOrderProperty,
+		formatProperty,
+		localeProperty,
+		formatStyleProperty,
+
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithOpenWorldHintAnnotation(false),
+	)
+	s.AddTool(dateInfo, DateInfo)
+
+	humanizeTime := mcp.NewTool("humanize_time",
+		mcp.WithDescription(humanizeDescription),
+		mcp.WithString("time",
+			mcp.Description("The time to describe, in any format."),
+			mcp.Required(),
+		),
+		mcp.WithString("reference_time",
+			mcp.Description("The reference time, in any format. Defaults to the current time."),
+		),
+		inputFormatProperty,
+		dateOrderProperty,
+		mcp.WithString("granularity",
+			mcp.Description("The smallest unit to show."),
+			mcp.Enum(datetime.GetHumanizeUnits()...),
+			mcp.DefaultString("second"),
+		),
+		mcp.WithNumber("precision",
+			mcp.Description("The maximum number of units to show (e.g., 2 for '2 days, 3 hours')."),
+			mcp.DefaultNumber(1),
+			mcp.Min(1),
+			mcp.Max(6),
+		),
+		mcp.WithObject("thresholds",
+			mcp.Description("The amounts from which a difference is expressed in the next larger unit. Missing fields use the defaults."),
+			mcp.Properties(map[string]any{
+				"seconds": map[string]any{"type": "number", "description": "Seconds from which minutes are used. Defaults to 45."},
+				"minutes": map[string]any{"type": "number", "description": "Minutes from which hours are used. Defaults to 45."},
+				"hours":   map[string]any{"type": "number", "description": "Hours from which days are used. Defaults to 22."},
+				"days":    map[string]any{"type": "number", "description": "Days from which months are used. Defaults to 26."},
+				"months":  map[string]any{"type": "number", "description": "Months from which years are used. Defaults to 11."},
+			}),
+		),
+		mcp.WithString("timezone",
+			mcp.Description("The timezone whose wall clock is used to count days, months and years, "+timezoneForms+". It is also used for input times without timezone."),
+			mcp.DefaultString(datetime.GetDefaultTimezone()),
+		),
+
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithOpenWorldHintAnnotation(false),
+	)
+	s.AddTool(humanizeTime, HumanizeTime)
+
+	parseTime := mcp.NewTool("parse_time",
+		mcp.WithDescription(parseTimeDescription),
+		mcp.WithString("time",
+			mcp.Description("The time to parse, in any format."),
+			mcp.Required(),
+		),
+		inputFormatProperty,
+		dateOrderProperty,
+		mcp.WithString("timezone",
+			mcp.Description("The timezone of input times without timezone, "+timezoneForms+"."),
+			mcp.DefaultString(datetime.GetDefaultTimezone()),
+		),
+		formatProperty,
+		localeProperty,
+		formatStyleProperty,
+
+		mcp.WithReadOnlyHintAnnotation(true),
+		mcp.WithDestructiveHintAnnotation(false),
+		mcp.WithIdempotentHintAnnotation(true),
+		mcp.WithOpenWorldHintAnnotation(false),
+	)
+	s.AddTool(parseTime, ParseTime)
 }
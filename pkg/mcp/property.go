@@ -1,11 +1,16 @@
 package mcp
 
 import (
+	"strings"
+
 	"github.com/mark3labs/mcp-go/mcp"
 
 	"github.com/TheoBrigitte/mcp-time/pkg/datetime"
 )
 
+// timezoneForms describes the accepted forms of a timezone, for property descriptions.
+const timezoneForms = "as an IANA name (e.g., 'America/New_York'), a UTC offset (e.g., '+05:30'), an abbreviation (e.g., 'PST') or latitude,longitude coordinates (e.g., '48.8566,2.3522')"
+
 var (
 	// timeProperty is a reusable MCP property for a time string input.
 	// It defaults to the current time if not provided.
@@ -19,9 +24,56 @@ var (
 		mcp.DefaultString(datetime.GetDefaultFormat()),
 	)
 
+	// localeProperty is a reusable MCP property for the language of the output time.
+	localeProperty = mcp.WithString("locale",
+		mcp.Description("The language of month and weekday names in the output, as a language tag (e.g., 'fr', 'de', 'ja'). Without a format, the medium date and time pattern of the locale is used. Available locales: "+strings.Join(datetime.GetLocales(), ", ")+". Defaults to English."),
+	)
+
+	// formatStyleProperty is a reusable MCP property for the style of the output time format.
+	formatStyleProperty = mcp.WithString("format_style",
+		mcp.Description("The style of a custom format: 'go' for Go layouts (e.g., '2006-01-02 15:04'), 'strftime' (e.g., '%Y-%m-%d %H:%M'), 'icu' for ICU and Java patterns (e.g., \"yyyy-MM-dd'T'HH:mm:ss.SSSXXX\") or 'moment' for Moment.js formats (e.g., 'YYYY-MM-DD HH:mm'). A custom format may also be prefixed by its style instead (e.g., 'strftime:%Y-%m-%d', 'moment:YYYY-MM-DD')."),
+		mcp.Enum(datetime.GetFormatStyles()...),
+		mcp.DefaultString(datetime.GetFormatStyles()[0]),
+	)
+
+	// inputFormatProperty is a reusable MCP property for the format of the input times.
+	inputFormatProperty = mcp.WithString("input_format",
+		mcp.Description("The format of the input times, instead of guessing it: a predefined format (e.g., 'RFC3339', 'DateTime'), 'Unix', 'UnixMilli', 'UnixMicro' or 'UnixNano' for an integer Unix timestamp in seconds, milliseconds, microseconds or nanoseconds (e.g., '1751968800123' with 'UnixMilli'), a Go layout (e.g., '02/01/2006 15:04') or a strftime format (e.g., 'strftime:%d/%m/%Y %H:%M', or '%d/%m/%Y %H:%M' with format_style 'strftime'). A style prefix takes precedence over format_style, and the 'icu' and 'moment' styles only apply to the output format. Without an output format, times are written in the input format."),
+	)
+
+	// dateOrderProperty is a reusable MCP property for the order of numeric dates in the input times.
+	dateOrderProperty = mcp.WithString("date_order",
+		mcp.Description("The order of the day, month and year used to read numeric input dates when guessing their format: 'MDY' (e.g., '01/02/2024' is January 2), 'DMY' (e.g., '01/02/2024' is February 1, and '1.2.24') or 'YMD'. Dates starting with a four-digit year are always read as year, month and day. Ignored when input_format is set."),
+		mcp.Enum(datetime.GetDateOrders()...),
+	)
+
 	// timezoneProperty is a reusable MCP property for specifying a timezone.
 	timezoneProperty = mcp.WithString("timezone",
-		mcp.Description("The target timezone for the output, in IANA format (e.g., 'America/New_York')."),
+		mcp.Description("The target timezone for the output, "+timezoneForms+"."),
 		mcp.DefaultString(datetime.GetDefaultTimezone()),
 	)
+
+	// countryProperty is a reusable MCP property for the country code of a holiday calendar.
+	countryProperty = mcp.WithString("country",
+		mcp.Description("The country code of the holiday calendar (e.g., 'US'). See the 'list_holidays' tool for the available countries. Defaults to no holidays."),
+	)
 )
+
+// requestFormat returns the output format of a request, from its format, locale and format_style properties.
+func requestFormat(request mcp.CallToolRequest) datetime.Format {
+	return datetime.Format{
+		Layout: request.GetString("format", ""),
+		Locale: request.GetString("locale", ""),
+		Style:  request.GetString("format_style", ""),
+	}
+}
+
+// requestInputFormat returns the input format of the request, read from its input_format, format_style and
+// date_order arguments. The format_style argument is shared with the output format, see datetime.InputFormat.
+func requestInputFormat(request mcp.CallToolRequest) datetime.InputFormat {
+	return datetime.InputFormat{
+		Layout:    request.GetString("input_format", ""),
+		Style:     request.GetString("format_style", ""),
+		DateOrder: request.GetString("date_order", ""),
+	}
+}
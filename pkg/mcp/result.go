@@ -0,0 +1,17 @@
+package mcp
+
+import (
+	"encoding/json"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// newToolResultJSON returns a tool result containing the JSON encoding of v.
+func newToolResultJSON(v any) *mcp.CallToolResult {
+	output, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error())
+	}
+
+	return mcp.NewToolResultText(string(output))
+}
@@ -10,10 +10,20 @@ import (
 )
 
 // CurrentTime is the handler for the 'current_time' MCP tool.
-// It returns the current time, optionally formatted and in a specific timezone.
+// It returns the current time, optionally formatted and in a specific timezone or location.
 func CurrentTime(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	timezone := request.GetString("timezone", "")
-	format := request.GetString("format", "")
+	location := request.GetString("location", "")
+	format := requestFormat(request)
+
+	// A location takes precedence over the timezone.
+	if location != "" {
+		var err error
+		timezone, err = datetime.LocationTimezone(location)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+	}
 
 	output, err := datetime.CurrentTime(timezone, format)
 	if err != nil {
@@ -29,9 +39,10 @@ func ConvertTime(ctx context.Context, request mcp.CallToolRequest) (r *mcp.CallT
 	inputTime := request.GetString("time", "")
 	inputTimezone := request.GetString("input_timezone", "")
 	outputTimezone := request.GetString("output_timezone", "")
-	format := request.GetString("format", "")
+	inputFormat := requestInputFormat(request)
+	format := requestFormat(request)
 
-	output, err := datetime.ConvertTime(inputTime, inputTimezone, outputTimezone, format)
+	output, err := datetime.ConvertTime(inputTime, inputTimezone, outputTimezone, inputFormat, format)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -45,9 +56,10 @@ func TimeAdd(ctx context.Context, request mcp.CallToolRequest) (r *mcp.CallToolR
 	inputTime := request.GetString("time", "")
 	duration := request.GetString("duration", "")
 	timezone := request.GetString("timezone", "")
-	format := request.GetString("format", "")
+	inputFormat := requestInputFormat(request)
+	format := requestFormat(request)
 
-	output, err := datetime.TimeAdd(inputTime, duration, timezone, format)
+	output, err := datetime.TimeAdd(inputTime, duration, timezone, inputFormat, format)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -60,10 +72,12 @@ func TimeAdd(ctx context.Context, request mcp.CallToolRequest) (r *mcp.CallToolR
 func RelativeTime(ctx context.Context, request mcp.CallToolRequest) (r *mcp.CallToolResult, err error) {
 	inputTime := request.GetString("time", "")
 	relativeTime := request.GetString("text", "")
+	language := request.GetString("language", "")
 	timezone := request.GetString("timezone", "")
-	format := request.GetString("format", "")
+	inputFormat := requestInputFormat(request)
+	format := requestFormat(request)
 
-	output, err := datetime.RelativeTime(inputTime, relativeTime, timezone, format)
+	output, err := datetime.RelativeTime(inputTime, relativeTime, language, timezone, inputFormat, format)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -78,8 +92,9 @@ func CompareTime(ctx context.Context, request mcp.CallToolRequest) (r *mcp.CallT
 	timeB := request.GetString("time_b", "")
 	timeATimezone := request.GetString("time_a_timezone", "")
 	timeBTimezone := request.GetString("time_b_timezone", "")
+	inputFormat := requestInputFormat(request)
 
-	result, err := datetime.CompareTime(timeA, timeB, timeATimezone, timeBTimezone)
+	result, err := datetime.CompareTime(timeA, timeB, timeATimezone, timeBTimezone, inputFormat)
 	if err != nil {
 		return mcp.NewToolResultError(err.Error()), nil
 	}
@@ -88,3 +103,334 @@ func CompareTime(ctx context.Context, request mcp.CallToolRequest) (r *mcp.CallT
 
 	return mcp.NewToolResultText(output), nil
 }
+
+// TimeDifference is the handler for the 'time_difference' MCP tool.
+// It returns the signed difference between two times.
+func TimeDifference(ctx context.Context, request mcp.CallToolRequest) (r *mcp.CallToolResult, err error) {
+	timeA := request.GetString("time_a", "")
+	timeB := request.GetString("time_b", "")
+	timeATimezone := request.GetString("time_a_timezone", "")
+	timeBTimezone := request.GetString("time_b_timezone", "")
+	inputFormat := requestInputFormat(request)
+
+	difference, err := datetime.TimeDifference(timeA, timeB, timeATimezone, timeBTimezone, inputFormat)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return newToolResultJSON(difference), nil
+}
+
+// BusinessDays is the handler for the 'business_days' MCP tool.
+// It adds business days to a time, or counts the business days between two times.
+func BusinessDays(ctx context.Context, request mcp.CallToolRequest) (r *mcp.CallToolResult, err error) {
+	operation := request.GetString("operation", "add")
+	inputTime := request.GetString("time", "")
+	weekend := request.GetStringSlice("weekend", nil)
+	country := request.GetString("country", "")
+	timezone := request.GetString("timezone", "")
+	inputFormat := requestInputFormat(request)
+
+	switch operation {
+	case "add":
+		days := request.GetInt("days", 0)
+		format := requestFormat(request)
+
+		output, err := datetime.AddBusinessDays(inputTime, days, weekend, country, timezone, inputFormat, format)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(output), nil
+	case "count":
+		endTime, err := request.RequireString("end_time")
+		if err != nil {
+			return mcp.NewToolResultError("missing_end_time: end_time is required to count business days"), nil
+		}
+
+		result, err := datetime.CountBusinessDays(inputTime, endTime, weekend, country, timezone, inputFormat)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+
+		return mcp.NewToolResultText(strconv.Itoa(result)), nil
+	default:
+		return mcp.NewToolResultError("invalid_operation: Unsupported operation: " + operation), nil
+	}
+}
+
+// IsHoliday is the handler for the 'is_holiday' MCP tool.
+// It checks whether the day of a time is a public holiday.
+func IsHoliday(ctx context.Context, request mcp.CallToolRequest) (r *mcp.CallToolResult, err error) {
+	inputTime := request.GetString("time", "")
+	country := request.GetString("country", "")
+	timezone := request.GetString("timezone", "")
+	inputFormat := requestInputFormat(request)
+
+	result, err := datetime.IsHoliday(inputTime, country, timezone, inputFormat)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return newToolResultJSON(result), nil
+}
+
+// ListHolidays is the handler for the 'list_holidays' MCP tool.
+// It lists the public holidays of a country for a year.
+func ListHolidays(ctx context.Context, request mcp.CallToolRequest) (r *mcp.CallToolResult, err error) {
+	country := request.GetString("country", "")
+	year := request.GetInt("year", 0)
+
+	result, err := datetime.ListHolidays(country, year)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return newToolResultJSON(result), nil
+}
+
+// CronNext is the handler for the 'cron_next' MCP tool.
+// It returns the next or previous occurrences of a cron expression.
+func CronNext(ctx context.Context, request mcp.CallToolRequest) (r *mcp.CallToolResult, err error) {
+	expression := request.GetString("expression", "")
+	inputTime := request.GetString("time", "")
+	timezone := request.GetString("timezone", "")
+	direction := request.GetString("direction", "")
+	count := request.GetInt("count", 1)
+	inputFormat := requestInputFormat(request)
+	format := requestFormat(request)
+
+	output, err := datetime.CronNext(expression, inputTime, timezone, direction, count, inputFormat, format)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return newToolResultJSON(output), nil
+}
+
+// ExpandRecurrence is the handler for the 'expand_recurrence' MCP tool.
+// It expands an iCalendar recurrence rule into its occurrences.
+func ExpandRecurrence(ctx context.Context, request mcp.CallToolRequest) (r *mcp.CallToolResult, err error) {
+	dtstart := request.GetString("dtstart", "")
+	rule := request.GetString("rrule", "")
+	exdates := request.GetStringSlice("exdates", nil)
+	timezone := request.GetString("timezone", "")
+	windowStart := request.GetString("window_start", "")
+	windowEnd := request.GetString("window_end", "")
+	limit := request.GetInt("limit", 100)
+	inputFormat := requestInputFormat(request)
+	format := requestFormat(request)
+
+	output, err := datetime.ExpandRecurrence(dtstart, rule, exdates, timezone, windowStart, windowEnd, limit, inputFormat, format)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return newToolResultJSON(output), nil
+}
+
+// TimezoneInfo is the handler for the 'timezone_info' MCP tool.
+// It describes a timezone at a given time.
+func TimezoneInfo(ctx context.Context, request mcp.CallToolRequest) (r *mcp.CallToolResult, err error) {
+	timezone := request.GetString("timezone", "")
+	inputTime := request.GetString("time", "")
+	inputFormat := requestInputFormat(request)
+	format := requestFormat(request)
+
+	info, err := datetime.GetTimezoneInfo(timezone, inputTime, inputFormat, format)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return newToolResultJSON(info), nil
+}
+
+// ListTimezones is the handler for the 'list_timezones' MCP tool.
+// It lists the available timezones.
+func ListTimezones(ctx context.Context, request mcp.CallToolRequest) (r *mcp.CallToolResult, err error) {
+	region := request.GetString("region", "")
+	contains := request.GetString("contains", "")
+	offset := request.GetString("offset", "")
+	inputTime := request.GetString("time", "")
+	inputFormat := requestInputFormat(request)
+
+	entries, err := datetime.ListTimezones(region, contains, offset, inputTime, inputFormat)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return newToolResultJSON(entries), nil
+}
+
+// FindTimezone is the handler for the 'find_timezone' MCP tool.
+// It finds the timezone of a place.
+func FindTimezone(ctx context.Context, request mcp.CallToolRequest) (r *mcp.CallToolResult, err error) {
+	query := request.GetString("query", "")
+	limit := request.GetInt("limit", 5)
+
+	candidates, err := datetime.FindTimezone(query, limit)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return newToolResultJSON(candidates), nil
+}
+
+// TimezoneForCoordinates is the handler for the 'timezone_for_coordinates' MCP tool.
+// It finds the timezone of geographic coordinates.
+func TimezoneForCoordinates(ctx context.Context, request mcp.CallToolRequest) (r *mcp.CallToolResult, err error) {
+	latitude, err := request.RequireFloat("latitude")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	longitude, err := request.RequireFloat("longitude")
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	inputTime := request.GetString("time", "")
+	inputFormat := requestInputFormat(request)
+	format := requestFormat(request)
+
+	result, err := datetime.TimezoneForCoordinates(latitude, longitude, inputTime, inputFormat, format)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return newToolResultJSON(result), nil
+}
+
+// WorldClock is the handler for the 'world_clock' MCP tool.
+// It returns the local time of several timezones.
+func WorldClock(ctx context.Context, request mcp.CallToolRequest) (r *mcp.CallToolResult, err error) {
+	timezones := request.GetStringSlice("timezones", nil)
+	inputTime := request.GetString("time", "")
+	workStart := request.GetString("work_start", "")
+	workEnd := request.GetString("work_end", "")
+	weekend := request.GetStringSlice("weekend", nil)
+	inputFormat := requestInputFormat(request)
+	format := requestFormat(request)
+
+	entries, err := datetime.WorldClock(timezones, inputTime, workStart, workEnd, weekend, inputFormat, format)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return newToolResultJSON(entries), nil
+}
+
+// FindMeetingSlots is the handler for the 'find_meeting_slots' MCP tool.
+// It finds meeting slots across the working hours of participants.
+func FindMeetingSlots(ctx context.Context, request mcp.CallToolRequest) (r *mcp.CallToolResult, err error) {
+	var args struct {
+		Participants []datetime.MeetingParticipant `json:"participants"`
+	}
+	if err := request.BindArguments(&args); err != nil {
+		return mcp.NewToolResultError("invalid_participants: " + err.Error()), nil
+	}
+	start := request.GetString("start", "")
+	end := request.GetString("end", "")
+	duration := request.GetString("duration", "")
+	limit := request.GetInt("limit", 10)
+	inputFormat := requestInputFormat(request)
+	format := requestFormat(request)
+
+	slots, err := datetime.FindMeetingSlots(args.Participants, start, end, duration, limit, inputFormat, format)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return newToolResultJSON(slots), nil
+}
+
+// PeriodBounds is the handler for the 'period_bounds' MCP tool.
+// It returns the bounds of the calendar period containing a time.
+func PeriodBounds(ctx context.Context, request mcp.CallToolRequest) (r *mcp.CallToolResult, err error) {
+	inputTime := request.GetString("time", "")
+	unit := request.GetString("unit", "")
+	offset := request.GetInt("offset", 0)
+	timezone := request.GetString("timezone", "")
+	weekStart := request.GetString("week_start", "")
+	inputFormat := requestInputFormat(request)
+	format := requestFormat(request)
+
+	period, err := datetime.PeriodBounds(inputTime, unit, offset, timezone, weekStart, inputFormat, format)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return newToolResultJSON(period), nil
+}
+
+// RoundTime is the handler for the 'round_time' MCP tool.
+// It rounds a time to an interval.
+func RoundTime(ctx context.Context, request mcp.CallToolRequest) (r *mcp.CallToolResult, err error) {
+	inputTime := request.GetString("time", "")
+	interval := request.GetString("interval", "")
+	mode := request.GetString("mode", "")
+	timezone := request.GetString("timezone", "")
+	inputFormat := requestInputFormat(request)
+	format := requestFormat(request)
+
+	output, err := datetime.RoundTime(inputTime, interval, mode, timezone, inputFormat, format)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return mcp.NewToolResultText(output), nil
+}
+
+// DateInfo is the handler for the 'date_info' MCP tool.
+// It returns calendar facts about a time.
+func DateInfo(ctx context.Context, request mcp.CallToolRequest) (r *mcp.CallToolResult, err error) {
+	inputTime := request.GetString("time", "")
+	timezone := request.GetString("timezone", "")
+	inputFormat := requestInputFormat(request)
+	format := requestFormat(request)
+
+	info, err := datetime.GetDateInfo(inputTime, timezone, inputFormat, format)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return newToolResultJSON(info), nil
+}
+
+// HumanizeTime is the handler for the 'humanize_time' MCP tool.
+// It renders a time relative to a reference time as human text.
+func HumanizeTime(ctx context.Context, request mcp.CallToolRequest) (r *mcp.CallToolResult, err error) {
+	var args struct {
+		Thresholds datetime.HumanizeThresholds `json:"thresholds"`
+	}
+	if err := request.BindArguments(&args); err != nil {
+		return mcp.NewToolResultError("invalid_thresholds: " + err.Error()), nil
+	}
+	inputTime := request.GetString("time", "")
+	referenceTime := request.GetString("reference_time", "")
+	granularity := request.GetString("granularity", "")
+	precision := request.GetInt("precision", 1)
+	timezone := request.GetString("timezone", "")
+	inputFormat := requestInputFormat(request)
+
+	output, err := datetime.Humanize(inputTime, referenceTime, timezone, granularity, precision, args.Thresholds, inputFormat)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return mcp.NewToolResultText(output), nil
+}
+
+// ParseTime is the handler for the 'parse_time' MCP tool.
+// It parses a time and reports how it was read.
+func ParseTime(ctx context.Context, request mcp.CallToolRequest) (r *mcp.CallToolResult, err error) {
+	inputTime := request.GetString("time", "")
+	timezone := request.GetString("timezone", "")
+	inputFormat := requestInputFormat(request)
+	format := requestFormat(request)
+
+	parsed, err := datetime.ParseTime(inputTime, timezone, inputFormat, format)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	return newToolResultJSON(parsed), nil
+}
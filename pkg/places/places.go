@@ -0,0 +1,332 @@
+// Package places provides an offline gazetteer, which maps place names (cities and countries) to their timezones.
+// The cities and countries are embedded in the binary. Countries and their timezones come from the IANA timezone
+// database (iso3166.tab and zone.tab).
+package places
+
+import (
+	"cmp"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// data contains the embedded cities and countries.
+//
+//go:embed data/*.json
+var data embed.FS
+
+// City is a city of the gazetteer.
+type City struct {
+	// Name is the usual English name of the city.
+	Name string `json:"name"`
+	// Country is the ISO 3166 country code of the city (e.g., "NG").
+	Country string `json:"country"`
+	// Timezone is the IANA timezone of the city.
+	Timezone string `json:"timezone"`
+	// Population is the approximate population of the city, used to rank cities sharing a name.
+	Population int `json:"population"`
+	// Aliases lists other names of the city (e.g., local names, former names, abbreviations).
+	Aliases []string `json:"aliases,omitempty"`
+}
+
+// Country is a country of the gazetteer.
+type Country struct {
+	// Code is the ISO 3166 country code (e.g., "NG").
+	Code string `json:"code"`
+	// Name is the usual English name of the country.
+	Name string `json:"name"`
+	// Aliases lists other names of the country.
+	Aliases []string `json:"aliases,omitempty"`
+	// Timezones lists the IANA timezones of the country, the most populated first.
+	Timezones []string `json:"timezones"`
+}
+
+var (
+	// cities lists the embedded cities.
+	cities []City
+	// countries maps upper case country codes to their country.
+	countries = map[string]*Country{}
+)
+
+// init loads the embedded cities and countries.
+func init() {
+	var countryList []*Country
+	for _, f := range []struct {
+		name string
+		v    any
+	}{
+		{"data/cities.json", &cities},
+		{"data/countries.json", &countryList},
+	} {
+		content, err := data.ReadFile(f.name)
+		if err != nil {
+			panic(err)
+		}
+
+		err = json.Unmarshal(content, f.v)
+		if err != nil {
+			panic(fmt.Errorf("invalid embedded file %s: %w", f.name, err))
+		}
+	}
+
+	for _, c := range countryList {
+		countries[c.Code] = c
+	}
+}
+
+// Match describes how a candidate matched a query, from the best to the worst.
+type Match string
+
+const (
+	// MatchExact is a match on the name of the place.
+	MatchExact Match = "exact"
+	// MatchAlias is a match on another name of the place, or on a country code.
+	MatchAlias Match = "alias"
+	// MatchPrefix is a match on the beginning of a name of the place.
+	MatchPrefix Match = "prefix"
+	// MatchFuzzy is a match on a name of the place with a few typos.
+	MatchFuzzy Match = "fuzzy"
+)
+
+// rank orders the kinds of matches.
+var rank = map[Match]int{MatchExact: 0, MatchAlias: 1, MatchPrefix: 2, MatchFuzzy: 3}
+
+// Candidate is a place matching a query.
+type Candidate struct {
+	// Name is the name of the place.
+	Name string `json:"name"`
+	// Kind is either "city" or "country".
+	Kind string `json:"kind"`
+	// Country is the ISO 3166 country code of the place.
+	Country string `json:"country"`
+	// CountryName is the name of the country of the place.
+	CountryName string `json:"country_name"`
+	// Timezone is the IANA timezone of the place.
+	Timezone string `json:"timezone"`
+	// Population is the approximate population of cities.
+	Population int `json:"population,omitempty"`
+	// Match describes how the place matched the query.
+	Match Match `json:"match"`
+}
+
+// Search returns up to limit places matching a query, the best match first.
+//
+// The query is a city or country name, compared case insensitively and ignoring accents and punctuation.
+// It may be qualified by a country name or code after a comma (e.g., "Portland, US", "Valencia, Venezuela").
+// Other qualifiers only match as part of a name or alias (e.g., "Washington, D.C."), so that "Paris, TX" does not
+// match Paris, France.
+// Candidates are ranked by match (exact name, alias, prefix, then names with a few typos), cities before
+// countries, and by decreasing population. A country matches once per timezone, in the order of its timezones.
+func Search(query string, limit int) []Candidate {
+	// Qualifiers which are not countries (e.g., states) are kept in the name, which must then match exactly.
+	name, qualifier, exact := query, "", false
+	if i := strings.LastIndexByte(query, ','); i >= 0 {
+		if c := lookupCountry(normalize(query[i+1:])); c != nil {
+			name, qualifier = query[:i], c.Code
+		} else {
+			exact = true
+		}
+	}
+
+	name = normalize(name)
+	if name == "" {
+		return nil
+	}
+
+	var candidates []Candidate
+	for _, city := range cities {
+		if qualifier != "" && city.Country != qualifier {
+			continue
+		}
+
+		match, ok := matchNames(name, city.Name, city.Aliases)
+		if !ok || (exact && rank[match] > rank[MatchAlias]) {
+			continue
+		}
+
+		candidates = append(candidates, Candidate{
+			Name:        city.Name,
+			Kind:        "city",
+			Country:     city.Country,
+			CountryName: countryName(city.Country),
+			Timezone:    city.Timezone,
+			Population:  city.Population,
+			Match:       match,
+		})
+	}
+
+	for _, code := range sortedCountryCodes() {
+		country := countries[code]
+		if qualifier != "" && code != qualifier {
+			continue
+		}
+
+		match, ok := matchNames(name, country.Name, country.Aliases)
+		if !ok && name == strings.ToLower(code) {
+			match, ok = MatchAlias, true
+		}
+		if !ok || (exact && rank[match] > rank[MatchAlias]) {
+			continue
+		}
+
+		for _, timezone := range country.Timezones {
+			candidates = append(candidates, Candidate{
+				Name:        country.Name,
+				Kind:        "country",
+				Country:     code,
+				CountryName: country.Name,
+				Timezone:    timezone,
+				Match:       match,
+			})
+		}
+	}
+
+	slices.SortStableFunc(candidates, func(a, b Candidate) int {
+		return cmp.Or(
+			cmp.Compare(rank[a.Match], rank[b.Match]),
+			cmp.Compare(a.Kind, b.Kind),
+			cmp.Compare(b.Population, a.Population),
+		)
+	})
+
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+
+	return candidates
+}
+
+// matchNames matches a normalized query against the name and aliases of a place.
+func matchNames(query, name string, aliases []string) (Match, bool) {
+	names := append([]string{normalize(name)}, aliases...)
+	for i := 1; i < len(names); i++ {
+		names[i] = normalize(names[i])
+	}
+
+	if query == names[0] {
+		return MatchExact, true
+	}
+	if slices.Contains(names[1:], query) {
+		return MatchAlias, true
+	}
+
+	// Short queries only match exactly, as they would match too many names otherwise.
+	if len(query) < 3 {
+		return "", false
+	}
+
+	for _, n := range names {
+		if strings.HasPrefix(n, query) {
+			return MatchPrefix, true
+		}
+	}
+
+	// Allow about one typo every four characters.
+	maxDistance := len(query) / 4
+	for _, n := range names {
+		if maxDistance > 0 && Levenshtein(query, n) <= maxDistance {
+			return MatchFuzzy, true
+		}
+	}
+
+	return "", false
+}
+
+// lookupCountry returns the country matching a normalized name, alias or code, or nil.
+func lookupCountry(name string) *Country {
+	if c, ok := countries[strings.ToUpper(name)]; ok {
+		return c
+	}
+
+	for _, code := range sortedCountryCodes() {
+		c := countries[code]
+		match, ok := matchNames(name, c.Name, c.Aliases)
+		if ok && (match == MatchExact || match == MatchAlias) {
+			return c
+		}
+	}
+
+	return nil
+}
+
+// countryName returns the name of a country code.
+func countryName(code string) string {
+	if c, ok := countries[code]; ok {
+		return c.Name
+	}
+
+	return code
+}
+
+// sortedCountryCodes returns the sorted country codes.
+func sortedCountryCodes() []string {
+	codes := make([]string, 0, len(countries))
+	for code := range countries {
+		codes = append(codes, code)
+	}
+	slices.Sort(codes)
+
+	return codes
+}
+
+// foldReplacer replaces accented and special letters with their plain equivalent.
+var foldReplacer = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ä", "a", "ã", "a", "å", "a", "ā", "a",
+	"ç", "c", "č", "c", "ć", "c",
+	"é", "e", "è", "e", "ê", "e", "ë", "e", "ē", "e", "ę", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i", "ī", "i",
+	"ñ", "n", "ń", "n",
+	"ó", "o", "ò", "o", "ô", "o", "ö", "o", "õ", "o", "ø", "o", "ō", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u", "ū", "u",
+	"ý", "y", "ÿ", "y",
+	"ł", "l", "ș", "s", "ş", "s", "š", "s", "ś", "s", "ț", "t", "ţ", "t", "ž", "z", "ź", "z", "ż", "z",
+	"đ", "d", "ß", "ss", "æ", "ae", "œ", "oe",
+)
+
+// normalize lower cases a name, replaces accented letters, removes apostrophes and
+// replaces other punctuation with spaces (e.g., "Saint-Denis" and "saint denis" are equal).
+func normalize(name string) string {
+	name = foldReplacer.Replace(strings.ToLower(name))
+
+	var b strings.Builder
+	for _, r := range name {
+		switch {
+		case r == '\'' || r == '’' || r == 'ʻ' || r == '.':
+			continue
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(r)
+		default:
+			b.WriteRune(' ')
+		}
+	}
+
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+// Levenshtein returns the edit distance between two strings, counted in runes.
+func Levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(rb)]
+}
@@ -0,0 +1,119 @@
+package places
+
+import (
+	"testing"
+	"time"
+)
+
+// TestData tests that the embedded cities and countries are consistent.
+func TestData(t *testing.T) {
+	for _, city := range cities {
+		if _, ok := countries[city.Country]; !ok {
+			t.Errorf("unknown country %s for city %s", city.Country, city.Name)
+		}
+		if _, err := time.LoadLocation(city.Timezone); err != nil {
+			t.Errorf("invalid timezone %s for city %s: %v", city.Timezone, city.Name, err)
+		}
+		if city.Population <= 0 {
+			t.Errorf("missing population for city %s", city.Name)
+		}
+	}
+
+	for code, country := range countries {
+		if len(country.Timezones) == 0 {
+			t.Errorf("missing timezones for country %s", code)
+		}
+		for _, timezone := range country.Timezones {
+			if _, err := time.LoadLocation(timezone); err != nil {
+				t.Errorf("invalid timezone %s for country %s: %v", timezone, code, err)
+			}
+		}
+	}
+}
+
+// TestSearch tests the best candidate returned by Search.
+func TestSearch(t *testing.T) {
+	tests := []struct {
+		query            string
+		expectedName     string
+		expectedTimezone string
+		expectedMatch    Match
+	}{
+		{"Lagos", "Lagos", "Africa/Lagos", MatchExact},
+		{"  lagos ", "Lagos", "Africa/Lagos", MatchExact},
+		{"Lagoss", "Lagos", "Africa/Lagos", MatchFuzzy},
+		{"Bombay", "Mumbai", "Asia/Kolkata", MatchAlias},
+		{"sao paulo", "São Paulo", "America/Sao_Paulo", MatchExact},
+		{"St Louis", "St. Louis", "America/Chicago", MatchExact},
+		{"Port au Prince", "Port-au-Prince", "America/Port-au-Prince", MatchExact},
+		{"Hamilton", "Hamilton", "America/Toronto", MatchExact},
+		{"Hamilton, Bermuda", "Hamilton", "Atlantic/Bermuda", MatchExact},
+		{"Victoria, SC", "Victoria", "Indian/Mahe", MatchExact},
+		{"Washington, D.C.", "Washington", "America/New_York", MatchAlias},
+		{"Vladivos", "Vladivostok", "Asia/Vladivostok", MatchPrefix},
+		{"Japan", "Japan", "Asia/Tokyo", MatchExact},
+		{"UK", "United Kingdom", "Europe/London", MatchAlias},
+		{"US", "United States", "America/New_York", MatchAlias},
+	}
+
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			candidates := Search(test.query, 5)
+			if len(candidates) == 0 {
+				t.Fatalf("expected candidates for %q", test.query)
+			}
+
+			c := candidates[0]
+			if c.Name != test.expectedName || c.Timezone != test.expectedTimezone || c.Match != test.expectedMatch {
+				t.Errorf("expected %s (%s, %s), got %s (%s, %s)", test.expectedName, test.expectedTimezone, test.expectedMatch, c.Name, c.Timezone, c.Match)
+			}
+		})
+	}
+}
+
+// TestSearchRanking tests the ranking and limit of Search.
+func TestSearchRanking(t *testing.T) {
+	candidates := Search("United States", 3)
+	if len(candidates) != 3 {
+		t.Fatalf("expected 3 candidates, got %d", len(candidates))
+	}
+	for i, timezone := range []string{"America/New_York", "America/Detroit", "America/Kentucky/Louisville"} {
+		if candidates[i].Timezone != timezone {
+			t.Errorf("expected candidate %d in %s, got %s", i, timezone, candidates[i].Timezone)
+		}
+	}
+
+	// Cities sharing a name are ranked by population.
+	candidates = Search("Santa Cruz", 2)
+	if len(candidates) != 2 || candidates[0].Name != "Santa Cruz de la Sierra" {
+		t.Errorf("expected Santa Cruz de la Sierra first, got %+v", candidates)
+	}
+
+	// Qualifiers which are not countries are not ignored.
+	for _, query := range []string{"Xyzzyville", "Paris, TX", "Lagos, Xyzzy"} {
+		if candidates := Search(query, 5); len(candidates) != 0 {
+			t.Errorf("expected no candidates for %q, got %+v", query, candidates)
+		}
+	}
+}
+
+// TestLevenshtein tests the edit distance between strings.
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"", "", 0},
+		{"lagos", "lagos", 0},
+		{"lagos", "lagoss", 1},
+		{"kitten", "sitting", 3},
+		{"são paulo", "sao paulo", 1},
+		{"", "abc", 3},
+	}
+
+	for _, test := range tests {
+		if distance := Levenshtein(test.a, test.b); distance != test.expected {
+			t.Errorf("expected distance %d between %q and %q, got %d", test.expected, test.a, test.b, distance)
+		}
+	}
+}
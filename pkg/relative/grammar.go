@@ -0,0 +1,462 @@
+package relative
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Grammar describes the words of relative time expressions in a language.
+// Words are compared case insensitively and ignoring accents, and may be phrases of several words.
+//
+// A grammar understands:
+//   - days relative to the reference day (e.g., "hier", "übermorgen"), at midnight,
+//   - weekdays, optionally preceded or followed by next or last (e.g., "lundi prochain", "nächsten Montag"), at midnight.
+//     A weekday alone is the last one, as in English,
+//   - offsets before or after the reference time (e.g., "il y a 3 jours", "in zwei Stunden", "dentro de una semana"),
+//     and units preceded or followed by next or last (e.g., "la semana que viene"), which keep the time of day,
+//   - times of day (e.g., "à 10h30", "um 10 Uhr", "a las diez de la noche", "midi").
+type Grammar struct {
+	// Language is the language code of the grammar (e.g., "fr").
+	Language string `json:"language"`
+	// Name is the English name of the language.
+	Name string `json:"name"`
+	// Now lists the words meaning the reference time.
+	Now []string `json:"now"`
+	// Days maps the words of days relative to the reference day to their number of days (e.g., "hier": -1).
+	Days map[string]int `json:"days"`
+	// Noon and Midnight list the words meaning 12:00 and 00:00.
+	Noon     []string `json:"noon"`
+	Midnight []string `json:"midnight"`
+	// Ago and In list the words preceding an offset before or after the reference time.
+	Ago []string `json:"ago"`
+	In  []string `json:"in"`
+	// Next and Last list the words preceding or following a weekday or a unit.
+	Next []string `json:"next"`
+	Last []string `json:"last"`
+	// At lists the words preceding a time of day.
+	At []string `json:"at"`
+	// Hours lists the words following the hour of a time of day (e.g., "Uhr").
+	Hours []string `json:"hours"`
+	// AM and PM list the words following a time of day in the morning or in the afternoon and evening.
+	AM []string `json:"am"`
+	PM []string `json:"pm"`
+	// Numbers maps number words to their value.
+	Numbers map[string]int `json:"numbers"`
+	// Units maps unit words to their unit: second, minute, hour, day, week, month or year.
+	Units map[string]string `json:"units"`
+	// Weekdays maps weekday words to their weekday, from 0 for Sunday.
+	Weekdays map[string]time.Weekday `json:"weekdays"`
+	// Fillers lists the words which are ignored (e.g., articles).
+	Fillers []string `json:"fillers"`
+
+	// entries lists the normalized words of the grammar, the longest phrases first.
+	entries []entry
+}
+
+// kind is the meaning of a word of a grammar.
+type kind int
+
+const (
+	kindNow kind = iota
+	kindDay
+	kindNoon
+	kindMidnight
+	kindAgo
+	kindIn
+	kindNext
+	kindLast
+	kindAt
+	kindHours
+	kindAM
+	kindPM
+	kindNumber
+	kindUnit
+	kindWeekday
+	kindFiller
+)
+
+// entry is a normalized word or phrase of a grammar.
+type entry struct {
+	words []string
+	kind  kind
+	value int
+	unit  string
+}
+
+// compile normalizes the words of the grammar.
+func (g *Grammar) compile() *Grammar {
+	add := func(k kind, phrase string, value int, unit string) {
+		g.entries = append(g.entries, entry{words: strings.Fields(normalize(phrase)), kind: k, value: value, unit: unit})
+	}
+	for k, phrases := range map[kind][]string{
+		kindNow: g.Now, kindNoon: g.Noon, kindMidnight: g.Midnight,
+		kindAgo: g.Ago, kindIn: g.In, kindNext: g.Next, kindLast: g.Last,
+		kindAt: g.At, kindHours: g.Hours, kindAM: g.AM, kindPM: g.PM, kindFiller: g.Fillers,
+	} {
+		for _, phrase := range phrases {
+			add(k, phrase, 0, "")
+		}
+	}
+	for phrase, days := range g.Days {
+		add(kindDay, phrase, days, "")
+	}
+	for phrase, n := range g.Numbers {
+		add(kindNumber, phrase, n, "")
+	}
+	for phrase, unit := range g.Units {
+		add(kindUnit, phrase, 0, unit)
+	}
+	for phrase, weekday := range g.Weekdays {
+		add(kindWeekday, phrase, int(weekday), "")
+	}
+
+	slices.SortStableFunc(g.entries, func(a, b entry) int { return cmp.Compare(len(b.words), len(a.words)) })
+
+	return g
+}
+
+// Parse returns the time described by an expression, relative to the reference time.
+func (g *Grammar) Parse(expression string, reference time.Time) (time.Time, error) {
+	p := &parser{entries: g.entries, words: strings.Fields(normalize(expression))}
+	if len(p.words) == 0 {
+		return time.Time{}, unknown("empty expression")
+	}
+
+	var r result
+	for len(p.words) > 0 {
+		err := p.phrase(&r, reference)
+		if err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	return r.apply(reference), nil
+}
+
+// parser consumes the words of an expression.
+type parser struct {
+	entries []entry
+	words   []string
+}
+
+// accept consumes the longest entry of the given kinds at the beginning of the remaining words.
+func (p *parser) accept(kinds ...kind) (entry, bool) {
+	for _, e := range p.entries {
+		if slices.Contains(kinds, e.kind) && len(e.words) <= len(p.words) && slices.Equal(e.words, p.words[:len(e.words)]) {
+			p.words = p.words[len(e.words):]
+			return e, true
+		}
+	}
+
+	return entry{}, false
+}
+
+// phrase consumes the next phrase of the expression into r.
+func (p *parser) phrase(r *result, reference time.Time) error {
+	if _, ok := p.accept(kindNow); ok {
+		return nil
+	}
+
+	if e, ok := p.accept(kindDay); ok {
+		r.setDay(e.value)
+		return nil
+	}
+
+	if _, ok := p.accept(kindAgo); ok {
+		return p.offset(r, -1)
+	}
+
+	if _, ok := p.accept(kindIn); ok {
+		return p.offset(r, 1)
+	}
+
+	// Next or last before a weekday or a unit (e.g., "nächsten Montag", "la próxima semana").
+	if m, ok := p.accept(kindNext, kindLast); ok {
+		if e, ok := p.accept(kindWeekday); ok {
+			r.setDay(weekdayShift(reference.Weekday(), time.Weekday(e.value), direction(m)))
+			return nil
+		}
+		if e, ok := p.accept(kindUnit); ok {
+			return r.add(direction(m), e.unit)
+		}
+		return unknown("expected a weekday or a unit after %q", strings.Join(m.words, " "))
+	}
+
+	// A weekday, optionally followed by next or last (e.g., "lundi prochain").
+	if e, ok := p.accept(kindWeekday); ok {
+		dir := -1
+		if m, ok := p.accept(kindNext, kindLast); ok {
+			dir = direction(m)
+		}
+		r.setDay(weekdayShift(reference.Weekday(), time.Weekday(e.value), dir))
+		return nil
+	}
+
+	// A unit followed by next or last (e.g., "semaine prochaine").
+	if e, ok := p.accept(kindUnit); ok {
+		m, ok := p.accept(kindNext, kindLast)
+		if !ok {
+			return unknown("expected next or last after %q", strings.Join(e.words, " "))
+		}
+		return r.add(direction(m), e.unit)
+	}
+
+	if _, ok := p.accept(kindAt); ok {
+		ok, err := p.clock(r, true)
+		if err == nil && !ok {
+			err = unknown("expected a time of day")
+		}
+		return err
+	}
+
+	if ok, err := p.clock(r, false); ok || err != nil {
+		return err
+	}
+
+	if _, ok := p.accept(kindFiller); ok {
+		return nil
+	}
+
+	return unknown("unexpected word %q", p.words[0])
+}
+
+// offset consumes an optional quantity and a unit, added to r in the given direction.
+func (p *parser) offset(r *result, sign int) error {
+	n := 1
+	if len(p.words) > 0 {
+		if v, err := strconv.Atoi(p.words[0]); err == nil {
+			n = v
+			p.words = p.words[1:]
+		} else if errors.Is(err, strconv.ErrRange) {
+			return fmt.Errorf("offset of %s out of range", p.words[0])
+		} else if e, ok := p.accept(kindNumber); ok {
+			n = e.value
+		}
+	}
+
+	e, ok := p.accept(kindUnit)
+	if !ok {
+		return unknown("expected a unit")
+	}
+
+	return r.add(sign*n, e.unit)
+}
+
+// clockPattern matches a time of day such as "10", "10h", "10h30" or "10:30".
+var clockPattern = regexp.MustCompile(`^(\d{1,2})(?:([:h])(\d{2})?)?$`)
+
+// clock consumes a time of day into r, and reports whether there was one.
+// A bare number (e.g., "10") or number word is only a time of day after an "at" word, or when followed by an hours word.
+func (p *parser) clock(r *result, afterAt bool) (bool, error) {
+	if _, ok := p.accept(kindNoon); ok {
+		r.setClock(12, 0)
+		return true, nil
+	}
+	if _, ok := p.accept(kindMidnight); ok {
+		r.setClock(0, 0)
+		return true, nil
+	}
+
+	if len(p.words) == 0 {
+		return false, nil
+	}
+
+	words := p.words
+	var hour, minute int
+	var marked bool
+	if m := clockPattern.FindStringSubmatch(p.words[0]); m != nil {
+		hour, _ = strconv.Atoi(m[1])
+		minute, _ = strconv.Atoi(cmp.Or(m[3], "0"))
+		marked = m[2] != ""
+		p.words = p.words[1:]
+	} else if e, ok := p.accept(kindNumber); ok && afterAt {
+		hour = e.value
+	} else {
+		p.words = words
+		return false, nil
+	}
+
+	if !marked {
+		if _, ok := p.accept(kindHours); ok {
+			marked = true
+			// Minutes may follow the hours word (e.g., "10 Uhr 30").
+			if len(p.words) > 0 && len(p.words[0]) == 2 {
+				if v, err := strconv.Atoi(p.words[0]); err == nil {
+					minute = v
+					p.words = p.words[1:]
+				}
+			}
+		}
+	}
+	if !marked && !afterAt {
+		p.words = words
+		return false, nil
+	}
+
+	if _, ok := p.accept(kindAM); ok && hour == 12 {
+		hour = 0
+	} else if _, ok := p.accept(kindPM); ok && hour < 12 {
+		hour += 12
+	}
+
+	if hour > 23 || minute > 59 {
+		return false, fmt.Errorf("invalid time of day %02d:%02d", hour, minute)
+	}
+	r.setClock(hour, minute)
+
+	return true, nil
+}
+
+// unknown returns an error wrapping ErrUnknownExpression, for expressions which the grammar does not understand.
+func unknown(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", ErrUnknownExpression, fmt.Sprintf(format, args...))
+}
+
+// direction returns 1 for a next entry, and -1 for a last entry.
+func direction(e entry) int {
+	if e.kind == kindNext {
+		return 1
+	}
+
+	return -1
+}
+
+// weekdayShift returns the number of days from a weekday to the next (dir > 0) or last (dir < 0) given weekday,
+// which is never the same day.
+func weekdayShift(from, to time.Weekday, dir int) int {
+	if dir > 0 {
+		return (int(to)-int(from)+6)%7 + 1
+	}
+
+	return -((int(from)-int(to)+6)%7 + 1)
+}
+
+// result accumulates the phrases of an expression.
+type result struct {
+	// days is the number of days from the reference day, used when daySet is true.
+	days   int
+	daySet bool
+
+	// years, months, dateDays and duration are the offsets from the reference time.
+	years, months, dateDays int
+	duration                time.Duration
+
+	// hour and minute are the time of day, used when clockSet is true.
+	hour, minute int
+	clockSet     bool
+}
+
+// setDay sets the day relative to the reference day.
+func (r *result) setDay(days int) {
+	r.days, r.daySet = days, true
+}
+
+// setClock sets the time of day.
+func (r *result) setClock(hour, minute int) {
+	r.hour, r.minute, r.clockSet = hour, minute, true
+}
+
+// maxOffsetYears is the maximum offset from the reference time, in years.
+const maxOffsetYears = 10000
+
+// add adds n units to the offsets, and returns an error if they are out of range.
+func (r *result) add(n int, unit string) error {
+	outOfRange := fmt.Errorf("offset of %d %ss out of range", n, unit)
+	if n > maxOffsetYears*366*24*3600 || n < -maxOffsetYears*366*24*3600 {
+		return outOfRange
+	}
+
+	switch unit {
+	case "second", "minute", "hour":
+		d := map[string]time.Duration{"second": time.Second, "minute": time.Minute, "hour": time.Hour}[unit]
+		if int64(n) > math.MaxInt64/int64(d) || int64(n) < math.MinInt64/int64(d) {
+			return outOfRange
+		}
+		sum := r.duration + time.Duration(n)*d
+		if (n > 0 && sum < r.duration) || (n < 0 && sum > r.duration) {
+			return outOfRange
+		}
+		r.duration = sum
+	case "day":
+		r.dateDays += n
+	case "week":
+		r.dateDays += 7 * n
+	case "month":
+		r.months += n
+	case "year":
+		r.years += n
+	}
+
+	if abs(r.years)+abs(r.months)/12+abs(r.dateDays)/366 > maxOffsetYears {
+		return outOfRange
+	}
+
+	return nil
+}
+
+// abs returns the absolute value of n.
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+
+	return n
+}
+
+// apply returns the time described by r, relative to the reference time.
+func (r *result) apply(reference time.Time) time.Time {
+	t := reference
+	if r.daySet {
+		y, m, d := t.Date()
+		t = time.Date(y, m, d+r.days, 0, 0, 0, 0, t.Location())
+	}
+
+	if r.years != 0 || r.months != 0 || r.dateDays != 0 {
+		y, m, d := t.Date()
+		hour, minute, second := t.Clock()
+		// Clamp the day to the last day of the target month, so that January 31 + 1 month is February 28.
+		last := time.Date(y+r.years, m+time.Month(r.months)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+		t = time.Date(y+r.years, m+time.Month(r.months), min(d, last)+r.dateDays, hour, minute, second, t.Nanosecond(), t.Location())
+	}
+	t = t.Add(r.duration)
+
+	if r.clockSet {
+		y, m, d := t.Date()
+		t = time.Date(y, m, d, r.hour, r.minute, 0, 0, t.Location())
+	}
+
+	return t
+}
+
+// foldReplacer replaces accented letters with their plain equivalent.
+var foldReplacer = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ä", "a",
+	"ç", "c",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "î", "i", "ï", "i",
+	"ñ", "n",
+	"ó", "o", "ô", "o", "ö", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u",
+	"ß", "ss",
+)
+
+// normalize lower cases an expression, replaces accented letters and replaces punctuation other than
+// colons with spaces (e.g., "Après-demain" and "apres demain" are equal).
+func normalize(expression string) string {
+	expression = foldReplacer.Replace(strings.ToLower(expression))
+
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == ':' {
+			return r
+		}
+		return ' '
+	}, expression)
+}
@@ -0,0 +1,108 @@
+// Package relative parses relative time expressions (e.g., "yesterday at 10am", "hier à 10h", "nächsten Montag")
+// in several languages. English expressions are parsed by go-naturaldate, other languages by grammars
+// embedded in the binary. Parsers of other languages can be registered.
+package relative
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tj/go-naturaldate"
+)
+
+// data contains the embedded grammars, one file per language.
+//
+//go:embed data/*.json
+var data embed.FS
+
+// ErrUnknownExpression is returned, wrapped, by the grammars for expressions they do not understand,
+// as opposed to expressions which are understood but invalid (e.g., "à 25h").
+var ErrUnknownExpression = errors.New("unknown expression")
+
+// Parser parses the relative time expressions of a language.
+type Parser interface {
+	// Parse returns the time described by an expression, relative to the reference time.
+	Parse(expression string, reference time.Time) (time.Time, error)
+}
+
+// english parses English expressions with go-naturaldate.
+type english struct{}
+
+// Parse returns the time described by an English expression, relative to the reference time.
+func (english) Parse(expression string, reference time.Time) (time.Time, error) {
+	return naturaldate.Parse(expression, reference)
+}
+
+// English is the parser of English expressions, used as a fallback for other languages.
+var English Parser = english{}
+
+var (
+	// parsers maps lower case language codes to their parser.
+	parsers = map[string]Parser{"en": English}
+	// parsersMutex protects parsers.
+	parsersMutex sync.RWMutex
+)
+
+// init loads the embedded grammars.
+func init() {
+	entries, err := data.ReadDir("data")
+	if err != nil {
+		panic(err)
+	}
+
+	for _, entry := range entries {
+		content, err := data.ReadFile(path.Join("data", entry.Name()))
+		if err != nil {
+			panic(err)
+		}
+
+		var g Grammar
+		err = json.Unmarshal(content, &g)
+		if err != nil {
+			panic(fmt.Errorf("invalid embedded grammar %s: %w", entry.Name(), err))
+		}
+
+		Register(g.Language, g.compile())
+	}
+}
+
+// Register registers the parser of a language code (e.g., "fr"), replacing any existing parser.
+func Register(language string, p Parser) {
+	parsersMutex.Lock()
+	defer parsersMutex.Unlock()
+
+	parsers[strings.ToLower(language)] = p
+}
+
+// Lookup returns the parser of a language tag (e.g., "fr", "de-AT", "es_MX"), compared case insensitively.
+// Regional tags fall back to their language.
+func Lookup(language string) (Parser, bool) {
+	parsersMutex.RLock()
+	defer parsersMutex.RUnlock()
+
+	language = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(language), "_", "-"))
+	if p, ok := parsers[language]; ok {
+		return p, true
+	}
+
+	language, _, _ = strings.Cut(language, "-")
+	p, ok := parsers[language]
+
+	return p, ok
+}
+
+// Languages returns the sorted codes of the languages with a parser.
+func Languages() []string {
+	parsersMutex.RLock()
+	defer parsersMutex.RUnlock()
+
+	return slices.Sorted(maps.Keys(parsers))
+}
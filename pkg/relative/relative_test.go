@@ -0,0 +1,208 @@
+package relative
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestData tests that the embedded grammars are registered and complete.
+func TestData(t *testing.T) {
+	for _, language := range []string{"de", "en", "es", "fr"} {
+		if _, ok := Lookup(language); !ok {
+			t.Errorf("missing parser for %s", language)
+		}
+	}
+
+	for _, language := range Languages() {
+		p, _ := Lookup(language)
+		g, ok := p.(*Grammar)
+		if !ok {
+			continue
+		}
+
+		if len(g.Days) == 0 || len(g.Ago) == 0 || len(g.In) == 0 || len(g.Next) == 0 || len(g.Last) == 0 {
+			t.Errorf("incomplete grammar %s", language)
+		}
+
+		weekdays := map[time.Weekday]bool{}
+		for _, weekday := range g.Weekdays {
+			weekdays[weekday] = true
+		}
+		if len(weekdays) != 7 {
+			t.Errorf("missing weekdays in grammar %s", language)
+		}
+
+		for word, unit := range g.Units {
+			r := result{}
+			r.add(1, unit)
+			if r == (result{}) {
+				t.Errorf("unknown unit %s for %q in grammar %s", unit, word, language)
+			}
+		}
+	}
+}
+
+// TestLookup tests the language tags accepted by Lookup.
+func TestLookup(t *testing.T) {
+	for _, language := range []string{"fr", "FR", "de-AT", "es_MX", " en "} {
+		if _, ok := Lookup(language); !ok {
+			t.Errorf("expected a parser for %q", language)
+		}
+	}
+
+	for _, language := range []string{"", "xx", "klingon"} {
+		if _, ok := Lookup(language); ok {
+			t.Errorf("expected no parser for %q", language)
+		}
+	}
+}
+
+// TestParse tests expressions of the embedded grammars.
+func TestParse(t *testing.T) {
+	// Wednesday.
+	reference := time.Date(2025, time.July, 9, 12, 34, 56, 0, time.UTC)
+
+	tests := []struct {
+		language   string
+		expression string
+		expected   string
+	}{
+		{"fr", "maintenant", "2025-07-09T12:34:56Z"},
+		{"fr", "aujourd'hui", "2025-07-09T00:00:00Z"},
+		{"fr", "hier à 10h", "2025-07-08T10:00:00Z"},
+		{"fr", "Après-demain à midi", "2025-07-11T12:00:00Z"},
+		{"fr", "avant hier", "2025-07-07T00:00:00Z"},
+		{"fr", "il y a 3 jours", "2025-07-06T12:34:56Z"},
+		{"fr", "il y a une heure", "2025-07-09T11:34:56Z"},
+		{"fr", "dans deux semaines", "2025-07-23T12:34:56Z"},
+		{"fr", "lundi prochain à 14h30", "2025-07-14T14:30:00Z"},
+		{"fr", "mercredi dernier", "2025-07-02T00:00:00Z"},
+		{"fr", "vendredi", "2025-07-04T00:00:00Z"},
+		{"fr", "la semaine prochaine", "2025-07-16T12:34:56Z"},
+		{"fr", "le mois dernier", "2025-06-09T12:34:56Z"},
+		{"fr", "demain à 9 heures du soir", "2025-07-10T21:00:00Z"},
+		{"fr", "à 18:45", "2025-07-09T18:45:00Z"},
+		{"de", "jetzt", "2025-07-09T12:34:56Z"},
+		{"de", "nächsten Montag", "2025-07-14T00:00:00Z"},
+		{"de", "nächsten Montag um 10 Uhr", "2025-07-14T10:00:00Z"},
+		{"de", "gestern um 10 Uhr 30", "2025-07-08T10:30:00Z"},
+		{"de", "übermorgen", "2025-07-11T00:00:00Z"},
+		{"de", "vor 3 Tagen", "2025-07-06T12:34:56Z"},
+		{"de", "vor einer Stunde", "2025-07-09T11:34:56Z"},
+		{"de", "in zwei Stunden", "2025-07-09T14:34:56Z"},
+		{"de", "letzte Woche", "2025-07-02T12:34:56Z"},
+		{"de", "am Freitag um 8 Uhr abends", "2025-07-04T20:00:00Z"},
+		{"de", "morgen Mittag", "2025-07-10T12:00:00Z"},
+		{"es", "ahora mismo", "2025-07-09T12:34:56Z"},
+		{"es", "ayer", "2025-07-08T00:00:00Z"},
+		{"es", "mañana a las 10", "2025-07-10T10:00:00Z"},
+		{"es", "pasado mañana a las diez de la noche", "2025-07-11T22:00:00Z"},
+		{"es", "hace 2 horas", "2025-07-09T10:34:56Z"},
+		{"es", "dentro de 3 días", "2025-07-12T12:34:56Z"},
+		{"es", "en un mes", "2025-08-09T12:34:56Z"},
+		{"es", "el próximo lunes", "2025-07-14T00:00:00Z"},
+		{"es", "el lunes que viene a las 9:15", "2025-07-14T09:15:00Z"},
+		{"es", "la semana pasada", "2025-07-02T12:34:56Z"},
+		{"es", "a la una de la tarde", "2025-07-09T13:00:00Z"},
+		// Month offsets never overflow into the next month.
+		{"fr", "dans 1 mois", "2025-08-09T12:34:56Z"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.language+" "+test.expression, func(t *testing.T) {
+			p, _ := Lookup(test.language)
+			output, err := p.Parse(test.expression, reference)
+			if err != nil {
+				t.Errorf("unexpected error %v", err)
+				return
+			}
+
+			if got := output.Format(time.RFC3339); got != test.expected {
+				t.Errorf("expected %s, got %s", test.expected, got)
+			}
+		})
+	}
+}
+
+// TestParseInvalid tests that the grammars reject expressions they do not understand.
+func TestParseInvalid(t *testing.T) {
+	reference := time.Date(2025, time.July, 9, 12, 34, 56, 0, time.UTC)
+
+	tests := []struct {
+		language   string
+		expression string
+	}{
+		{"fr", ""},
+		{"fr", "yesterday"},
+		{"fr", "il y a 3"},
+		{"fr", "10"},
+		{"fr", "à 25h"},
+		{"de", "nächsten"},
+		{"es", "semana"},
+		// A trailing "at" word, without a time of day.
+		{"fr", "à"},
+		{"fr", "hier à"},
+		{"de", "um"},
+		{"es", "a las"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.language+" "+test.expression, func(t *testing.T) {
+			p, _ := Lookup(test.language)
+			if output, err := p.Parse(test.expression, reference); err == nil {
+				t.Errorf("expected an error, got %s", output)
+			}
+		})
+	}
+}
+
+// TestParseUnknown tests that only expressions which are not understood are reported as unknown.
+func TestParseUnknown(t *testing.T) {
+	reference := time.Date(2025, time.July, 9, 12, 34, 56, 0, time.UTC)
+
+	tests := []struct {
+		language        string
+		expression      string
+		expectedUnknown bool
+	}{
+		{"fr", "yesterday", true},
+		{"fr", "il y a 3", true},
+		{"de", "nächsten", true},
+		{"fr", "hier à", true},
+		{"fr", "à 25h", false},
+		{"de", "um 25 Uhr", false},
+		{"fr", "dans 99999999999 heures", false},
+		{"fr", "dans 99999999999999999999 secondes", false},
+		{"es", "dentro de 20000 años", false},
+		{"de", "in 5000 Jahren und in 6000 Jahren", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.language+" "+test.expression, func(t *testing.T) {
+			p, _ := Lookup(test.language)
+			output, err := p.Parse(test.expression, reference)
+			if err == nil {
+				t.Errorf("expected an error, got %s", output)
+				return
+			}
+
+			if errors.Is(err, ErrUnknownExpression) != test.expectedUnknown {
+				t.Errorf("expected unknown %v, got %v", test.expectedUnknown, err)
+			}
+		})
+	}
+}
+
+// TestParseMonthEnd tests that month offsets are clamped to the last day of the month.
+func TestParseMonthEnd(t *testing.T) {
+	p, _ := Lookup("de")
+	output, err := p.Parse("in einem Monat", time.Date(2025, time.January, 31, 9, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if expected := time.Date(2025, time.February, 28, 9, 0, 0, 0, time.UTC); !output.Equal(expected) {
+		t.Errorf("expected %s, got %s", expected, output)
+	}
+}
@@ -0,0 +1,158 @@
+// Package scheduling finds meeting slots across the working hours of participants in different timezones.
+//
+// Working hours are defined on the wall clock of each participant, so that slots follow daylight saving time
+// changes of every timezone independently.
+package scheduling
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// defaultStep is the interval between candidate slot starts used when none is specified.
+const defaultStep = 15 * time.Minute
+
+// Participant is a person attending a meeting.
+type Participant struct {
+	// Name identifies the participant.
+	Name string
+	// Location is the timezone of the participant.
+	Location *time.Location
+	// WorkStart and WorkEnd are the working hours, as durations since midnight on the wall clock of the participant.
+	// Working hours span midnight when WorkEnd is before WorkStart.
+	WorkStart, WorkEnd time.Duration
+	// WorkingDay reports whether the day of a time, in the location of the participant, is a working day.
+	// Working hours starting on other days are ignored. If nil, every day is a working day.
+	WorkingDay func(day time.Time) bool
+}
+
+// window returns the working hours of the participant starting on the given day, and whether it is a working day.
+func (p Participant) window(year int, month time.Month, day int) (start, end time.Time, ok bool) {
+	midnight := time.Date(year, month, day, 0, 0, 0, 0, p.Location)
+	if p.WorkingDay != nil && !p.WorkingDay(midnight) {
+		return start, end, false
+	}
+
+	// Wall clocks are resolved by time.Date, so that working hours follow daylight saving time.
+	endDay := day
+	if p.WorkEnd <= p.WorkStart {
+		endDay++
+	}
+	start = time.Date(year, month, day, 0, 0, 0, int(p.WorkStart), p.Location)
+	end = time.Date(year, month, endDay, 0, 0, 0, int(p.WorkEnd), p.Location)
+
+	return start, end, true
+}
+
+// InHours reports whether the interval from start to end is entirely within working hours of the participant.
+func (p Participant) InHours(start, end time.Time) bool {
+	year, month, day := start.In(p.Location).Date()
+
+	// Working hours spanning midnight may have started on the previous day.
+	for _, d := range []int{day - 1, day} {
+		ws, we, ok := p.window(year, month, d)
+		if ok && !start.Before(ws) && !end.After(we) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Options defines the search for meeting slots.
+type Options struct {
+	// Start and End bound the search: slots start at or after Start and end at or before End.
+	Start, End time.Time
+	// Duration is the length of the meeting.
+	Duration time.Duration
+	// Step is the interval between candidate slot starts, aligned on UTC. Slots also start at the beginning of
+	// the working hours of each participant. Defaults to 15 minutes.
+	Step time.Duration
+	// Limit is the maximum number of slots returned. Zero means no limit.
+	Limit int
+}
+
+// Slot is a candidate meeting slot.
+type Slot struct {
+	// Start and End are the bounds of the slot.
+	Start, End time.Time
+	// InHours lists, for each participant in order, whether the slot is within their working hours.
+	InHours []bool
+	// Count is the number of participants for which the slot is within working hours.
+	Count int
+}
+
+// FindSlots returns the meeting slots within working hours of at least one participant, ranked by the number
+// of participants in working hours, then chronologically. Returned slots do not overlap: a slot overlapping a
+// better ranked one is skipped.
+func FindSlots(participants []Participant, opts Options) []Slot {
+	if opts.Step <= 0 {
+		opts.Step = defaultStep
+	}
+	if opts.Duration <= 0 || !opts.Start.Before(opts.End) {
+		return nil
+	}
+
+	var slots []Slot
+	for _, start := range candidates(participants, opts) {
+		slot := Slot{Start: start, End: start.Add(opts.Duration), InHours: make([]bool, len(participants))}
+		for i, p := range participants {
+			if p.InHours(slot.Start, slot.End) {
+				slot.InHours[i] = true
+				slot.Count++
+			}
+		}
+
+		if slot.Count > 0 {
+			slots = append(slots, slot)
+		}
+	}
+
+	slices.SortStableFunc(slots, func(a, b Slot) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), a.Start.Compare(b.Start))
+	})
+
+	var selected []Slot
+	for _, slot := range slots {
+		if opts.Limit > 0 && len(selected) >= opts.Limit {
+			break
+		}
+
+		overlaps := slices.ContainsFunc(selected, func(s Slot) bool {
+			return slot.Start.Before(s.End) && s.Start.Before(slot.End)
+		})
+		if !overlaps {
+			selected = append(selected, slot)
+		}
+	}
+
+	return selected
+}
+
+// candidates returns the sorted candidate slot starts: every step, and the beginning of the working hours of each
+// participant, as long as the slot fits within the search bounds.
+func candidates(participants []Participant, opts Options) []time.Time {
+	last := opts.End.Add(-opts.Duration)
+
+	var starts []time.Time
+	for t := opts.Start.Truncate(opts.Step); !t.After(last); t = t.Add(opts.Step) {
+		if !t.Before(opts.Start) {
+			starts = append(starts, t)
+		}
+	}
+
+	for _, p := range participants {
+		year, month, day := opts.Start.In(p.Location).Date()
+		for d := day - 1; !time.Date(year, month, d, 0, 0, 0, 0, p.Location).After(last); d++ {
+			ws, _, ok := p.window(year, month, d)
+			if ok && !ws.Before(opts.Start) && !ws.After(last) {
+				starts = append(starts, ws)
+			}
+		}
+	}
+
+	slices.SortFunc(starts, func(a, b time.Time) int { return a.Compare(b) })
+
+	return slices.CompactFunc(starts, func(a, b time.Time) bool { return a.Equal(b) })
+}
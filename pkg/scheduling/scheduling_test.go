@@ -0,0 +1,113 @@
+package scheduling
+
+import (
+	"testing"
+	"time"
+)
+
+// mustLoadLocation loads a location or fails the test.
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return location
+}
+
+// weekdays reports whether the day is a weekday.
+func weekdays(day time.Time) bool {
+	return day.Weekday() != time.Saturday && day.Weekday() != time.Sunday
+}
+
+// TestFindSlots tests the slots found for participants in different timezones.
+func TestFindSlots(t *testing.T) {
+	newYork := Participant{Name: "ny", Location: mustLoadLocation(t, "America/New_York"), WorkStart: 9 * time.Hour, WorkEnd: 17 * time.Hour, WorkingDay: weekdays}
+	london := Participant{Name: "london", Location: mustLoadLocation(t, "Europe/London"), WorkStart: 9 * time.Hour, WorkEnd: 17 * time.Hour, WorkingDay: weekdays}
+
+	tests := []struct {
+		name     string
+		day      time.Time
+		expected []time.Time
+	}{
+		{
+			// New York (UTC-4) and London (UTC+1) overlap from 13:00 to 16:00 UTC.
+			name:     "summer",
+			day:      time.Date(2025, time.July, 7, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{time.Date(2025, time.July, 7, 13, 0, 0, 0, time.UTC), time.Date(2025, time.July, 7, 14, 0, 0, 0, time.UTC), time.Date(2025, time.July, 7, 15, 0, 0, 0, time.UTC)},
+		},
+		{
+			// New York already moved to daylight saving time (UTC-4), but not London (UTC+0): they overlap from 13:00 to 17:00 UTC.
+			name:     "dst",
+			day:      time.Date(2025, time.March, 11, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{time.Date(2025, time.March, 11, 13, 0, 0, 0, time.UTC), time.Date(2025, time.March, 11, 14, 0, 0, 0, time.UTC), time.Date(2025, time.March, 11, 15, 0, 0, 0, time.UTC), time.Date(2025, time.March, 11, 16, 0, 0, 0, time.UTC)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			slots := FindSlots([]Participant{newYork, london}, Options{Start: test.day, End: test.day.Add(24 * time.Hour), Duration: time.Hour})
+
+			var full []time.Time
+			for _, slot := range slots {
+				if slot.Count == 2 {
+					full = append(full, slot.Start.UTC())
+				}
+			}
+
+			if len(full) != len(test.expected) {
+				t.Fatalf("expected %v, got %v", test.expected, full)
+			}
+			for i := range full {
+				if !full[i].Equal(test.expected[i]) {
+					t.Errorf("expected %v, got %v", test.expected, full)
+				}
+			}
+
+			// Slots with fewer participants in working hours come after.
+			if slots[len(full)-1].Count != 2 || (len(slots) > len(full) && slots[len(full)].Count != 1) {
+				t.Errorf("unexpected ranking %+v", slots)
+			}
+		})
+	}
+}
+
+// TestFindSlotsWindowStart tests that slots start at the beginning of working hours not aligned on the step.
+func TestFindSlotsWindowStart(t *testing.T) {
+	kathmandu := Participant{Name: "kathmandu", Location: mustLoadLocation(t, "Asia/Kathmandu"), WorkStart: 9 * time.Hour, WorkEnd: 10 * time.Hour}
+	start := time.Date(2025, time.July, 7, 0, 0, 0, 0, time.UTC)
+
+	slots := FindSlots([]Participant{kathmandu}, Options{Start: start, End: start.Add(24 * time.Hour), Duration: time.Hour, Step: time.Hour})
+	if len(slots) != 1 || !slots[0].Start.Equal(time.Date(2025, time.July, 7, 3, 15, 0, 0, time.UTC)) {
+		t.Errorf("expected a slot at 03:15 UTC, got %+v", slots)
+	}
+}
+
+// TestInHours tests working hours, including working hours spanning midnight and days off.
+func TestInHours(t *testing.T) {
+	paris := mustLoadLocation(t, "Europe/Paris")
+	night := Participant{Location: paris, WorkStart: 22 * time.Hour, WorkEnd: 6 * time.Hour, WorkingDay: weekdays}
+
+	tests := []struct {
+		name     string
+		start    time.Time
+		expected bool
+	}{
+		{"evening", time.Date(2025, time.July, 7, 22, 0, 0, 0, paris), true},
+		{"midnight", time.Date(2025, time.July, 7, 23, 30, 0, 0, paris), true},
+		{"morning", time.Date(2025, time.July, 8, 5, 0, 0, 0, paris), true},
+		{"end", time.Date(2025, time.July, 8, 5, 30, 0, 0, paris), false},
+		{"day", time.Date(2025, time.July, 8, 12, 0, 0, 0, paris), false},
+		{"after friday", time.Date(2025, time.July, 12, 1, 0, 0, 0, paris), true},
+		{"saturday", time.Date(2025, time.July, 12, 23, 0, 0, 0, paris), false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := night.InHours(test.start, test.start.Add(time.Hour)); got != test.expected {
+				t.Errorf("expected %t, got %t", test.expected, got)
+			}
+		})
+	}
+}